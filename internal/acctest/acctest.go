@@ -153,12 +153,14 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			configureStandInProvider(p)
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -192,6 +194,8 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		configureStandInProvider(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -214,6 +218,8 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		configureStandInProvider(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -312,7 +318,10 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// A local AWS stand-in accepts any credentials.
+		if standInEndpoint() == "" {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		}
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
 			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
@@ -328,6 +337,8 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		region := Region()
 		os.Setenv(envvar.DefaultRegion, region)
 
+		configureStandInProvider(Provider)
+
 		Provider.TerraformVersion = "1.0.0"
 		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
@@ -908,7 +919,13 @@ func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceS
 
 // AccountID returns the account ID of Provider
 // Must be used within a resource.TestCheckFunc
+// When VCR testing is enabled, the account ID of the current test's VCR-enabled provider is returned,
+// so that replayed tests use the recorded (redacted) account ID.
 func AccountID(ctx context.Context) string {
+	if meta, ok := vcrProviderMeta(ctx); ok {
+		return meta.AccountID(ctx)
+	}
+
 	return ProviderAccountID(ctx, Provider)
 }
 
@@ -1698,6 +1715,8 @@ func RegisterServiceErrorCheckFunc(serviceID string, f ServiceErrorCheckFunc) {
 func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 	t.Helper()
 
+	preCheckStandInServices(t, serviceIDs...)
+
 	return func(err error) error {
 		if err == nil {
 			return nil
@@ -1718,6 +1737,10 @@ func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 			t.Skipf("skipping test for %s/%s: %s", Partition(), Region(), err.Error())
 		}
 
+		if errorCheckStandIn(err) {
+			t.Skipf("skipping test; local AWS stand-in: %s", err.Error())
+		}
+
 		return err
 	}
 }
@@ -39,9 +39,20 @@ func logger(ctx context.Context, t *testing.T, name string) context.Context {
 	return ctx
 }
 
-// testNameContext adds the current test name to loggers.
+type testNameContextKeyType int
+
+var testNameContextKey testNameContextKeyType
+
+// testNameFromContext returns the name of the test for which the Context was created.
+func testNameFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(testNameContextKey).(string)
+	return v, ok
+}
+
+// testNameContext adds the current test name to loggers and the Context.
 func testNameContext(ctx context.Context, testName string) context.Context {
 	ctx = tflog.SetField(ctx, "test_name", testName)
+	ctx = context.WithValue(ctx, testNameContextKey, testName)
 
 	return ctx
 }
@@ -3,7 +3,35 @@
 
 package acctest
 
+import (
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder                    = closeVCRRecorder
+	FetchStandInSupportedServices       = fetchStandInSupportedServices
+	StandInEndpointKeys                 = standInEndpointKeys
+	StandInProviderConfigureContextFunc = standInProviderConfigureContextFunc
+	StandInSupportsService              = standInSupportsService
+	VCRRequestBodiesMatch               = vcrRequestBodiesMatch
 )
+
+// RedactVCRInteractions runs the VCR redaction pipeline as if the specified interactions were recorded and saved.
+func RedactVCRInteractions(interactions ...*cassette.Interaction) error {
+	pipeline := newVCRRedactionPipeline()
+
+	for _, i := range interactions {
+		if err := pipeline.discoverHook(i); err != nil {
+			return err
+		}
+	}
+
+	for _, i := range interactions {
+		if err := pipeline.redactHook(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
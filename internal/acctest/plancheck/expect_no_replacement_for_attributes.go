@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var _ plancheck.PlanCheck = expectNoReplacementForAttributesCheck{}
+
+type expectNoReplacementForAttributesCheck struct {
+	base           Base
+	attributePaths []tfjsonpath.Path
+}
+
+func (e expectNoReplacementForAttributesCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	for _, v := range resource.Change.ReplacePaths {
+		replacePath := replacePathString(v)
+
+		for _, attributePath := range e.attributePaths {
+			// A change to a nested attribute replaces the resource if the enclosing attribute is listed.
+			if p := attributePath.String(); replacePath == p || strings.HasPrefix(replacePath, p+".") {
+				response.Error = fmt.Errorf("%s - change to attribute at path: %s requires replacement", resource.Address, replacePath)
+
+				return
+			}
+		}
+	}
+}
+
+// replacePathString returns the string form of a plan's replace path, matching tfjsonpath.Path's String method.
+func replacePathString(v any) string {
+	steps, ok := v.([]any)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+
+	parts := make([]string, 0, len(steps))
+	for _, step := range steps {
+		parts = append(parts, fmt.Sprintf("%v", step))
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// ExpectNoReplacementForAttributes returns a plan check that fails if a change to any of the specified attributes,
+// or to any value nested within them, requires the resource to be replaced.
+func ExpectNoReplacementForAttributes(resourceAddress string, attributePaths ...tfjsonpath.Path) plancheck.PlanCheck {
+	return expectNoReplacementForAttributesCheck{
+		base:           NewBase(resourceAddress),
+		attributePaths: attributePaths,
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestExpectNoReplacementForAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		replacePaths   []any
+		attributePaths []tfjsonpath.Path
+		expectError    bool
+	}{
+		"no replacement": {
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("description")},
+		},
+		"other attribute replaced": {
+			replacePaths:   []any{[]any{"name"}},
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("description")},
+		},
+		"attribute replaced": {
+			replacePaths:   []any{[]any{"name"}, []any{"description"}},
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("description")},
+			expectError:    true,
+		},
+		"nested attribute replaced": {
+			replacePaths:   []any{[]any{"rule", float64(0), "priority"}},
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("rule")},
+			expectError:    true,
+		},
+		"nested attribute path replaced": {
+			replacePaths:   []any{[]any{"rule", float64(1), "priority"}},
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("rule").AtSliceIndex(1).AtMapKey("priority")},
+			expectError:    true,
+		},
+		"sibling nested attribute replaced": {
+			replacePaths:   []any{[]any{"rule", float64(1), "priority"}},
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("rule").AtSliceIndex(0)},
+		},
+		"attribute name prefix": {
+			replacePaths:   []any{[]any{"description_long"}},
+			attributePaths: []tfjsonpath.Path{tfjsonpath.New("description")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := plancheck.CheckPlanRequest{
+				Plan: &tfjson.Plan{
+					ResourceChanges: []*tfjson.ResourceChange{
+						{
+							Address: "test_resource.test",
+							Change: &tfjson.Change{
+								ReplacePaths: testCase.replacePaths,
+							},
+						},
+					},
+				},
+			}
+			var response plancheck.CheckPlanResponse
+
+			ExpectNoReplacementForAttributes("test_resource.test", testCase.attributePaths...).CheckPlan(context.Background(), request, &response)
+
+			if got, want := response.Error != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", response.Error, want)
+			}
+		})
+	}
+}
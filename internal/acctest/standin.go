@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	// standInCredentials are the static credentials sent to a local AWS stand-in if no others are configured.
+	standInCredentials = "test"
+	// standInHealthPath is the path at which a local AWS stand-in reports the services that it supports.
+	// The response format is that of LocalStack, e.g. {"services": {"s3": "available", "sqs": "running"}}.
+	standInHealthPath = "/_localstack/health"
+)
+
+// standInEndpoint returns the URL of the local AWS stand-in, if acceptance tests are to be run against one.
+func standInEndpoint() string {
+	return strings.TrimSuffix(os.Getenv(envvar.StandInEndpoint), "/")
+}
+
+// configureStandInProvider configures a provider instance to send all requests to the local AWS stand-in, if any.
+func configureStandInProvider(provider *schema.Provider) {
+	if endpoint := standInEndpoint(); endpoint != "" {
+		provider.ConfigureContextFunc = standInProviderConfigureContextFunc(provider.ConfigureContextFunc, standInEndpointKeys(provider), endpoint)
+	}
+}
+
+// standInEndpointKeys returns the keys of the provider's "endpoints" configuration block.
+func standInEndpointKeys(provider *schema.Provider) map[string]bool {
+	keys := make(map[string]bool)
+
+	if v, ok := provider.Schema["endpoints"]; ok {
+		if v, ok := v.Elem.(*schema.Resource); ok {
+			for k := range v.Schema {
+				keys[k] = true
+			}
+		}
+	}
+
+	return keys
+}
+
+// standInProviderConfigureContextFunc returns a provider configuration function that points every service client
+// at the specified endpoint and disables checks that a local AWS stand-in cannot satisfy.
+// endpointKeys are the keys of the provider's "endpoints" configuration block.
+// Endpoints and credentials set in the provider configuration take precedence.
+func standInProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc, endpointKeys map[string]bool, endpoint string) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		values := map[string]any{
+			"s3_use_path_style":           true,
+			"skip_credentials_validation": true,
+			"skip_metadata_api_check":     "true",
+			"skip_region_validation":      true,
+		}
+
+		if d.Get("access_key").(string) == "" && d.Get("profile").(string) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.Profile) == "" {
+			values["access_key"] = standInCredentials
+			values["secret_key"] = standInCredentials
+		}
+
+		endpoints, err := standInEndpoints(d, endpointKeys, endpoint)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		values["endpoints"] = endpoints
+
+		for k, v := range values {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "configuring provider for local AWS stand-in (%s): %s", k, err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// standInEndpoints returns the provider's "endpoints" configuration with the endpoint of every service that is not
+// already configured set to the specified endpoint.
+// The provider's "endpoints" block is usually absent, in which case every service is redirected.
+func standInEndpoints(d *schema.ResourceData, endpointKeys map[string]bool, endpoint string) ([]any, error) {
+	tfMap := make(map[string]any)
+	if v := d.Get("endpoints").(*schema.Set).List(); len(v) > 0 && v[0] != nil {
+		tfMap = v[0].(map[string]any)
+	}
+
+	serviceData, err := standInServiceData()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sd := range serviceData {
+		keys := append([]string{sd.ProviderPackage()}, sd.Aliases()...)
+
+		var configured bool
+		for _, k := range keys {
+			if v, ok := tfMap[k].(string); ok && v != "" {
+				configured = true
+				break
+			}
+		}
+
+		if !configured && endpointKeys[sd.ProviderPackage()] {
+			tfMap[sd.ProviderPackage()] = endpoint
+		}
+	}
+
+	return []any{tfMap}, nil
+}
+
+var standInServiceData = sync.OnceValues(func() ([]data.ServiceRecord, error) {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, fmt.Errorf("reading service data: %w", err)
+	}
+
+	return serviceData, nil
+})
+
+// standInSupportedServices returns the lower-case names of the services supported by the local AWS stand-in.
+// A nil result indicates that the supported services are unknown, so all services are assumed to be supported.
+var standInSupportedServices = sync.OnceValue(func() map[string]bool {
+	if v := os.Getenv(envvar.StandInServices); v != "" {
+		services := make(map[string]bool)
+		for service := range strings.SplitSeq(v, ",") {
+			services[strings.ToLower(strings.TrimSpace(service))] = true
+		}
+		return services
+	}
+
+	services, err := fetchStandInSupportedServices(standInEndpoint())
+	if err != nil {
+		log.Printf("[WARN] Unable to read services supported by local AWS stand-in, assuming all services are supported: %s", err)
+		return nil
+	}
+
+	return services
+})
+
+// fetchStandInSupportedServices reads the services reported as available by a local AWS stand-in.
+func fetchStandInSupportedServices(endpoint string) (map[string]bool, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	response, err := client.Get(endpoint + standInHealthPath)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %d", standInHealthPath, response.StatusCode)
+	}
+
+	var health struct {
+		Services map[string]string `json:"services"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&health); err != nil {
+		return nil, fmt.Errorf("%s: %w", standInHealthPath, err)
+	}
+
+	services := make(map[string]bool)
+	for service, status := range health.Services {
+		if status == "available" || status == "running" {
+			services[strings.ToLower(service)] = true
+		}
+	}
+
+	return services, nil
+}
+
+// standInSupportsService returns whether the local AWS stand-in supports the service with the specified AWS SDK service ID.
+// A service is matched by its provider package name, AWS CLI command, ARN namespace or service ID.
+func standInSupportsService(serviceID string, supported map[string]bool) bool {
+	if supported == nil {
+		return true
+	}
+
+	candidates := []string{strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))}
+
+	if serviceData, err := standInServiceData(); err == nil {
+		for _, sd := range serviceData {
+			if sd.SDKID() == serviceID {
+				candidates = append(candidates, sd.ProviderPackage(), sd.AWSCLIV2Command(), sd.AWSCLIV2CommandNoDashes(), sd.ARNNamespace())
+			}
+		}
+	}
+
+	for _, v := range candidates {
+		if supported[strings.ToLower(v)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preCheckStandInServices skips the current test if it uses a service that the local AWS stand-in does not support.
+func preCheckStandInServices(t *testing.T, serviceIDs ...string) {
+	t.Helper()
+
+	if standInEndpoint() == "" {
+		return
+	}
+
+	supported := standInSupportedServices()
+	for _, serviceID := range serviceIDs {
+		if !standInSupportsService(serviceID, supported) {
+			t.Skipf("skipping test; local AWS stand-in does not support %s", serviceID)
+		}
+	}
+}
+
+// errorCheckStandIn returns whether the specified error indicates that the local AWS stand-in does not implement an operation.
+func errorCheckStandIn(err error) bool {
+	if standInEndpoint() == "" {
+		return false
+	}
+
+	for _, needle := range []string{
+		"has not been implemented",
+		"not yet implemented",
+		"NotImplemented",
+	} {
+		if strings.Contains(err.Error(), needle) {
+			return true
+		}
+	}
+
+	return false
+}
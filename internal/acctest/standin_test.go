@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFetchStandInSupportedServices(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_localstack/health" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"services": {"s3": "running", "sqs": "available", "ec2": "disabled"}}`)
+	}))
+	defer server.Close()
+
+	got, err := acctest.FetchStandInSupportedServices(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, map[string]bool{"s3": true, "sqs": true}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStandInSupportsService(t *testing.T) {
+	t.Parallel()
+
+	supported := map[string]bool{
+		"logs":   true,
+		"s3":     true,
+		"states": true,
+	}
+
+	testCases := map[string]struct {
+		serviceID string
+		supported map[string]bool
+		expected  bool
+	}{
+		"provider package": {
+			serviceID: names.S3ServiceID,
+			supported: supported,
+			expected:  true,
+		},
+		"ARN namespace": {
+			serviceID: names.SFNServiceID,
+			supported: supported,
+			expected:  true,
+		},
+		"alias": {
+			serviceID: names.LogsServiceID,
+			supported: supported,
+			expected:  true,
+		},
+		"unsupported": {
+			serviceID: names.EC2ServiceID,
+			supported: supported,
+		},
+		"unknown supported services": {
+			serviceID: names.EC2ServiceID,
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := acctest.StandInSupportsService(testCase.serviceID, testCase.supported), testCase.expected; got != want {
+				t.Errorf("StandInSupportsService(%q) = %t, want %t", testCase.serviceID, got, want)
+			}
+		})
+	}
+}
+
+func TestStandInProviderConfigureContextFunc(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_PROFILE", "")
+
+	const endpoint = "http://localhost:5000"
+
+	testCases := map[string]struct {
+		config            map[string]any
+		expectedEndpoints map[string]any
+	}{
+		"no endpoints": {
+			config: map[string]any{},
+			expectedEndpoints: map[string]any{
+				"cloudwatchlogs": "",
+				"logs":           endpoint,
+				"s3":             endpoint,
+				"sqs":            endpoint,
+				"sts":            endpoint,
+			},
+		},
+		"configured endpoint": {
+			config: map[string]any{
+				"endpoints": []any{map[string]any{"sqs": "http://localhost:9324"}},
+			},
+			expectedEndpoints: map[string]any{
+				"cloudwatchlogs": "",
+				"logs":           endpoint,
+				"s3":             endpoint,
+				"sqs":            "http://localhost:9324",
+				"sts":            endpoint,
+			},
+		},
+		"configured alias": {
+			config: map[string]any{
+				"endpoints": []any{map[string]any{"cloudwatchlogs": "http://localhost:4586"}},
+			},
+			expectedEndpoints: map[string]any{
+				"cloudwatchlogs": "http://localhost:4586",
+				"logs":           "",
+				"s3":             endpoint,
+				"sqs":            endpoint,
+				"sts":            endpoint,
+			},
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // uses t.Setenv
+		t.Run(name, func(t *testing.T) {
+			endpointsSchema := make(map[string]*schema.Schema)
+			for _, k := range []string{"s3", "sqs", "sts", "cloudwatchlogs", "logs"} {
+				endpointsSchema[k] = &schema.Schema{Type: schema.TypeString, Optional: true}
+			}
+
+			var got map[string]any
+			provider := &schema.Provider{
+				Schema: map[string]*schema.Schema{
+					"access_key":                  {Type: schema.TypeString, Optional: true},
+					"endpoints":                   {Type: schema.TypeSet, Optional: true, Elem: &schema.Resource{Schema: endpointsSchema}},
+					"profile":                     {Type: schema.TypeString, Optional: true},
+					"s3_use_path_style":           {Type: schema.TypeBool, Optional: true},
+					"secret_key":                  {Type: schema.TypeString, Optional: true},
+					"skip_credentials_validation": {Type: schema.TypeBool, Optional: true},
+					"skip_metadata_api_check":     {Type: schema.TypeString, Optional: true},
+					"skip_region_validation":      {Type: schema.TypeBool, Optional: true},
+				},
+			}
+			provider.ConfigureContextFunc = acctest.StandInProviderConfigureContextFunc(func(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+				got = map[string]any{
+					"access_key":                  d.Get("access_key"),
+					"endpoints":                   d.Get("endpoints").(*schema.Set).List()[0],
+					"s3_use_path_style":           d.Get("s3_use_path_style"),
+					"skip_credentials_validation": d.Get("skip_credentials_validation"),
+				}
+				return nil, nil
+			}, acctest.StandInEndpointKeys(provider), endpoint)
+
+			diags := provider.Configure(t.Context(), terraformsdk.NewResourceConfigRaw(testCase.config))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			expected := map[string]any{
+				"access_key":                  "test",
+				"endpoints":                   testCase.expectedEndpoints,
+				"s3_use_path_style":           true,
+				"skip_credentials_validation": true,
+			}
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
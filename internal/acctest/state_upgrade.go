@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// CheckUpgradeResourceState runs saved resource state through the current provider's UpgradeResourceState RPC
+// and compares the result with the expected state.
+//
+// stateFile contains the JSON state written by an earlier provider release, typically one in which the resource
+// was implemented using Plugin SDK v2, with the specified schema version.
+// expectedFile contains the JSON state expected after the upgrade, in the current schema.
+// This catches differences, such as null and zero values, that would otherwise surface as unexpected plan diffs
+// after the resource is migrated to Terraform Plugin Framework.
+func CheckUpgradeResourceState(ctx context.Context, t *testing.T, typeName string, version int64, stateFile, expectedFile string) {
+	t.Helper()
+
+	server, err := ProtoV5ProviderFactories[ProviderName]()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	schemaResponse, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+	checkProtoV5Diagnostics(t, "getting provider schema", schemaResponse.Diagnostics)
+
+	schema, ok := schemaResponse.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("resource type %s not found", typeName)
+	}
+	typ := schema.ValueType()
+
+	state, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatalf("reading state file: %s", err)
+	}
+
+	response, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov5.RawState{
+			JSON: state,
+		},
+	})
+	if err != nil {
+		t.Fatalf("upgrading %s state (%s): %s", typeName, stateFile, err)
+	}
+	checkProtoV5Diagnostics(t, "upgrading "+typeName+" state ("+stateFile+")", response.Diagnostics)
+
+	if response.UpgradedState == nil {
+		t.Fatalf("upgrading %s state (%s): no upgraded state", typeName, stateFile)
+	}
+
+	got, err := response.UpgradedState.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("decoding upgraded %s state: %s", typeName, err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatalf("reading expected state file: %s", err)
+	}
+
+	want, err := tfprotov5.RawState{JSON: expected}.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("decoding expected %s state (%s): %s", typeName, expectedFile, err)
+	}
+
+	if got.Equal(want) {
+		return
+	}
+
+	diffs, err := got.Diff(want)
+	if err != nil {
+		t.Fatalf("comparing upgraded %s state: %s", typeName, err)
+	}
+
+	for _, diff := range diffs {
+		t.Errorf("upgraded %s state (%s): %s: got %s, want %s", typeName, stateFile, diff.Path, diff.Value1, diff.Value2)
+	}
+}
+
+func checkProtoV5Diagnostics(t *testing.T, action string, diags []*tfprotov5.Diagnostic) {
+	t.Helper()
+
+	var failed bool
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: %s: %s", action, d.Summary, d.Detail)
+			failed = true
+		}
+	}
+
+	if failed {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ statecheck.StateCheck = expectRegionOverrideCheck{}
+
+type expectRegionOverrideCheck struct {
+	base   Base
+	region string
+}
+
+func (e expectRegionOverrideCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	region, ok := resource.AttributeValues[names.AttrRegion].(string)
+	if !ok {
+		response.Error = fmt.Errorf("%s - attribute %q not found or not a string", e.base.ResourceAddress(), names.AttrRegion)
+		return
+	}
+
+	if region != e.region {
+		response.Error = fmt.Errorf("%s - expected %s %q, got %q", e.base.ResourceAddress(), names.AttrRegion, e.region, region)
+		return
+	}
+
+	// The resource's ARN, if it has one, must be in the overriding Region.
+	if v, ok := resource.AttributeValues[names.AttrARN].(string); ok && arn.IsARN(v) {
+		resourceARN, err := arn.Parse(v)
+		if err != nil {
+			response.Error = fmt.Errorf("%s - parsing %s: %w", e.base.ResourceAddress(), names.AttrARN, err)
+			return
+		}
+
+		if resourceARN.Region != e.region {
+			response.Error = fmt.Errorf("%s - expected %s %q in Region %q, got %q", e.base.ResourceAddress(), names.AttrARN, v, e.region, resourceARN.Region)
+			return
+		}
+	}
+}
+
+// ExpectRegionOverride returns a state check that a resource supporting the region attribute was created in the specified Region.
+// The resource's region attribute and the Region in its arn attribute, if any, must both match.
+func ExpectRegionOverride(resourceAddress, region string) statecheck.StateCheck {
+	return expectRegionOverrideCheck{
+		base:   NewBase(resourceAddress),
+		region: region,
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpectRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeValues map[string]any
+		expectError     bool
+	}{
+		"region": {
+			attributeValues: map[string]any{
+				names.AttrRegion: "us-east-2", //lintignore:AWSAT003
+			},
+		},
+		"region and ARN": {
+			attributeValues: map[string]any{
+				names.AttrARN:    "arn:aws:sqs:us-east-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+				names.AttrRegion: "us-east-2",                               //lintignore:AWSAT003
+			},
+		},
+		"other region": {
+			attributeValues: map[string]any{
+				names.AttrRegion: "us-west-2", //lintignore:AWSAT003
+			},
+			expectError: true,
+		},
+		"ARN in other region": {
+			attributeValues: map[string]any{
+				names.AttrARN:    "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+				names.AttrRegion: "us-east-2",                               //lintignore:AWSAT003
+			},
+			expectError: true,
+		},
+		"ARN not an ARN": {
+			attributeValues: map[string]any{
+				names.AttrARN:    "test",
+				names.AttrRegion: "us-east-2", //lintignore:AWSAT003
+			},
+		},
+		"no region": {
+			attributeValues: map[string]any{},
+			expectError:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := statecheck.CheckStateRequest{
+				State: testState(testCase.attributeValues),
+			}
+			var response statecheck.CheckStateResponse
+
+			ExpectRegionOverride("test_resource.test", "us-east-2").CheckState(context.Background(), request, &response) //lintignore:AWSAT003
+
+			if got, want := response.Error != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", response.Error, want)
+			}
+		})
+	}
+}
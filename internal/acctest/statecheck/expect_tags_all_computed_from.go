@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ statecheck.StateCheck = expectTagsAllComputedFromCheck{}
+
+type expectTagsAllComputedFromCheck struct {
+	base        Base
+	defaultTags map[string]string
+	tags        map[string]string
+	ignoreKeys  []string
+}
+
+func (e expectTagsAllComputedFromCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	attributePath := tfjsonpath.New(names.AttrTagsAll)
+
+	value, err := tfjsonpath.Traverse(resource.AttributeValues, attributePath)
+	if err != nil {
+		response.Error = err
+		return
+	}
+
+	if err := knownvalue.MapExact(e.expected()).CheckValue(value); err != nil {
+		response.Error = fmt.Errorf("checking value for attribute at path: %s.%s, err: %w", e.base.ResourceAddress(), attributePath, err)
+		return
+	}
+}
+
+// expected returns the expected value of tags_all: the default tags overridden by the resource tags, without any ignored keys.
+func (e expectTagsAllComputedFromCheck) expected() map[string]knownvalue.Check {
+	tagsAll := maps.Clone(e.defaultTags)
+	if tagsAll == nil {
+		tagsAll = make(map[string]string)
+	}
+	maps.Copy(tagsAll, e.tags)
+	for _, key := range e.ignoreKeys {
+		delete(tagsAll, key)
+	}
+
+	checks := make(map[string]knownvalue.Check, len(tagsAll))
+	for k, v := range tagsAll {
+		checks[k] = knownvalue.StringExact(v)
+	}
+
+	return checks
+}
+
+// ExpectTagsAllComputedFrom returns a state check that the resource's tags_all attribute is the provider's default tags
+// merged with the resource's tags, less any keys ignored by the provider's ignore_tags configuration.
+func ExpectTagsAllComputedFrom(resourceAddress string, defaultTags, tags map[string]string, ignoreKeys ...string) statecheck.StateCheck {
+	return expectTagsAllComputedFromCheck{
+		base:        NewBase(resourceAddress),
+		defaultTags: defaultTags,
+		tags:        tags,
+		ignoreKeys:  ignoreKeys,
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testState(attributeValues map[string]any) *tfjson.State {
+	return &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address:         "test_resource.test",
+						AttributeValues: attributeValues,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExpectTagsAllComputedFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tagsAll     map[string]any
+		defaultTags map[string]string
+		tags        map[string]string
+		ignoreKeys  []string
+		expectError bool
+	}{
+		"empty": {
+			tagsAll: map[string]any{},
+		},
+		"merged": {
+			tagsAll:     map[string]any{"key1": "value1", "key2": "value2"},
+			defaultTags: map[string]string{"key1": "value1"},
+			tags:        map[string]string{"key2": "value2"},
+		},
+		"resource tag overrides default tag": {
+			tagsAll:     map[string]any{"key1": "resource"},
+			defaultTags: map[string]string{"key1": "provider"},
+			tags:        map[string]string{"key1": "resource"},
+		},
+		"default tag not overridden": {
+			tagsAll:     map[string]any{"key1": "provider"},
+			defaultTags: map[string]string{"key1": "provider"},
+			tags:        map[string]string{"key1": "resource"},
+			expectError: true,
+		},
+		"ignored key": {
+			tagsAll:     map[string]any{"key1": "value1"},
+			defaultTags: map[string]string{"key1": "value1", "ignored": "value"},
+			tags:        map[string]string{"ignored": "value"},
+			ignoreKeys:  []string{"ignored"},
+		},
+		"missing tag": {
+			tagsAll:     map[string]any{"key1": "value1"},
+			defaultTags: map[string]string{"key1": "value1"},
+			tags:        map[string]string{"key2": "value2"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := statecheck.CheckStateRequest{
+				State: testState(map[string]any{names.AttrTagsAll: testCase.tagsAll}),
+			}
+			var response statecheck.CheckStateResponse
+
+			ExpectTagsAllComputedFrom("test_resource.test", testCase.defaultTags, testCase.tags, testCase.ignoreKeys...).CheckState(context.Background(), request, &response)
+
+			if got, want := response.Error != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", response.Error, want)
+			}
+		})
+	}
+}
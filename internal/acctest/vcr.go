@@ -11,10 +11,13 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -88,6 +91,25 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 	return meta
 }
 
+// vcrProviderMeta returns the VCR-enabled provider's state for the test for which the Context was created.
+func vcrProviderMeta(ctx context.Context) (*conns.AWSClient, bool) {
+	if !vcr.IsEnabled() {
+		return nil, false
+	}
+
+	testName, ok := testNameFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	providerMetas.Lock()
+	defer providerMetas.Unlock()
+
+	meta, ok := providerMetas[testName]
+
+	return meta, ok
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use
 // with VCR
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
@@ -103,6 +125,7 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
+			configureStandInProvider(primary)
 			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
 
 			return providerServerFactory(), nil
@@ -182,41 +205,19 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 				return true
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				return tfjson.EqualStrings(body, i.Body)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
+			return vcrRequestBodiesMatch(ctx, r, body, i.Body)
 		}
 
 		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
 
+		// Replace account IDs, access key IDs and secrets in recorded interactions.
+		redactionPipeline := newVCRRedactionPipeline()
+
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.New(cassetteName,
 			recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+			recorder.WithHook(redactionPipeline.discoverHook, recorder.AfterCaptureHook),
+			recorder.WithHook(redactionPipeline.redactHook, recorder.BeforeSaveHook),
 			recorder.WithMatcher(matchFunc),
 			recorder.WithMode(vcrMode),
 			recorder.WithRealTransport(httpClient.Transport),
@@ -251,6 +252,112 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// vcrVolatileFormParameters are the per-service names of AWS Query and EC2 protocol request parameters
+// whose values vary between recording and replay, such as idempotency tokens and timestamps.
+// Services are identified by their SigV4 signing name. Names match the final component of a parameter's
+// name, e.g. "StartTime" matches "Filter.1.StartTime".
+var vcrVolatileFormParameters = map[string][]string{
+	"ec2":                  {"ClientToken", "EndTime", "StartTime"},
+	"elasticache":          {"EndTime", "StartTime"},
+	"elasticloadbalancing": {"ClientToken"},
+	"monitoring":           {"EndTime", "StartTime"},
+	"rds":                  {"EndTime", "StartTime"},
+	"redshift":             {"EndTime", "StartTime"},
+	"sns":                  {"MessageDeduplicationId"},
+	"sqs":                  {"MessageDeduplicationId"},
+}
+
+// vcrVolatileFormParametersAllServices are the names of request parameters that are volatile in all AWS Query and EC2 protocol services.
+var vcrVolatileFormParametersAllServices = []string{
+	"ClientRequestToken",
+	"IdempotencyToken",
+}
+
+// vcrRequestBodiesMatch returns whether an HTTP request's body matches a recorded request body
+// according to the request's AWS protocol.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrRequestBodiesMatch(ctx context.Context, r *http.Request, body, cassetteBody string) bool {
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	switch contentType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		return tfjson.EqualStrings(body, cassetteBody)
+
+	case "application/xml":
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var requestXml, cassetteXml any
+
+		if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := xml.Unmarshal([]byte(cassetteBody), &cassetteXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXml, cassetteXml)
+
+	case "application/x-www-form-urlencoded":
+		// AWS Query and EC2 protocol parameters might be the same, but reordered or with volatile values.
+		requestForm, err := url.ParseQuery(body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteForm, err := url.ParseQuery(cassetteBody)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		volatile := append(slices.Clone(vcrVolatileFormParametersAllServices), vcrVolatileFormParameters[vcrSigningName(r)]...)
+		for _, form := range []url.Values{requestForm, cassetteForm} {
+			for k := range form {
+				if slices.Contains(volatile, k[strings.LastIndex(k, ".")+1:]) {
+					delete(form, k)
+				}
+			}
+		}
+
+		return reflect.DeepEqual(requestForm, cassetteForm)
+	}
+
+	return false
+}
+
+// vcrSigningName returns the service signing name from the credential scope of an HTTP request's
+// SigV4 Authorization header, or "" if the request isn't signed.
+// Unlike the endpoint's host name, the signing name doesn't vary with FIPS, dual-stack or custom endpoints.
+func vcrSigningName(r *http.Request) string {
+	_, credential, ok := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+	if !ok {
+		return ""
+	}
+	credential, _, _ = strings.Cut(credential, ",")
+
+	// <access key ID>/<date>/<region>/<service>/aws4_request
+	if parts := strings.Split(credential, "/"); len(parts) == 5 {
+		return parts[3]
+	}
+
+	return ""
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing
 //
 // In RECORD_ONLY mode, generates a new seed and saves it to a file, using the
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// VCRRedaction is a step in the pipeline that replaces sensitive values in recorded VCR interactions
+// before the cassette is saved.
+// Values that a test may send back to AWS, such as account IDs, must be replaced with stable placeholders so that
+// replayed requests match the recorded requests.
+//
+// A new VCRRedaction is created for each cassette.
+type VCRRedaction interface {
+	// Discover is called with each interaction as it is captured, before any interaction is redacted.
+	Discover(*cassette.Interaction)
+	// Redact returns the specified value with any sensitive values replaced.
+	Redact(string) string
+}
+
+var (
+	vcrRedactionsLock sync.Mutex
+	vcrRedactions     = []func() VCRRedaction{
+		newVCRAccountIDRedaction,
+		newVCRAccessKeyIDRedaction,
+		newVCRSecretRedaction,
+	}
+)
+
+// RegisterVCRRedaction adds a step to the pipeline that redacts all VCR cassettes.
+func RegisterVCRRedaction(f func() VCRRedaction) {
+	vcrRedactionsLock.Lock()
+	defer vcrRedactionsLock.Unlock()
+
+	vcrRedactions = append(vcrRedactions, f)
+}
+
+// vcrRedactionPipeline runs all registered redaction steps for a single cassette.
+type vcrRedactionPipeline struct {
+	redactions []VCRRedaction
+}
+
+func newVCRRedactionPipeline() *vcrRedactionPipeline {
+	vcrRedactionsLock.Lock()
+	defer vcrRedactionsLock.Unlock()
+
+	pipeline := &vcrRedactionPipeline{}
+	for _, f := range vcrRedactions {
+		pipeline.redactions = append(pipeline.redactions, f())
+	}
+
+	return pipeline
+}
+
+// discoverHook is run by the recorder after each interaction is captured.
+// The captured interaction is returned to the caller, so must not be modified.
+func (p *vcrRedactionPipeline) discoverHook(i *cassette.Interaction) error {
+	for _, v := range p.redactions {
+		v.Discover(i)
+	}
+
+	return nil
+}
+
+// redactHook is run by the recorder on each interaction before the cassette is saved.
+func (p *vcrRedactionPipeline) redactHook(i *cassette.Interaction) error {
+	i.Request.URL = p.redact(i.Request.URL)
+	i.Request.Host = p.redact(i.Request.Host)
+	i.Request.Body = p.redact(i.Request.Body)
+	p.redactValues(i.Request.Form)
+	p.redactValues(i.Request.Headers)
+
+	body := p.redact(i.Response.Body)
+	if len(body) != len(i.Response.Body) && i.Response.ContentLength >= 0 {
+		i.Response.ContentLength = int64(len(body))
+		if _, ok := i.Response.Headers["Content-Length"]; ok {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+	i.Response.Body = body
+	p.redactValues(i.Response.Headers)
+
+	return nil
+}
+
+func (p *vcrRedactionPipeline) redact(s string) string {
+	for _, v := range p.redactions {
+		s = v.Redact(s)
+	}
+
+	return s
+}
+
+func (p *vcrRedactionPipeline) redactValues(values map[string][]string) {
+	for k, vs := range values {
+		for i, v := range vs {
+			values[k][i] = p.redact(v)
+		}
+	}
+}
+
+// vcrPlaceholders maps sensitive values to stable placeholders, assigned in the order in which the values are discovered.
+type vcrPlaceholders struct {
+	format       string
+	placeholders map[string]string
+}
+
+func newVCRPlaceholders(format string) *vcrPlaceholders {
+	return &vcrPlaceholders{
+		format:       format,
+		placeholders: make(map[string]string),
+	}
+}
+
+func (p *vcrPlaceholders) add(v string) {
+	if _, ok := p.placeholders[v]; !ok {
+		p.placeholders[v] = fmt.Sprintf(p.format, len(p.placeholders)+1)
+	}
+}
+
+func (p *vcrPlaceholders) get(v string) (string, bool) {
+	placeholder, ok := p.placeholders[v]
+	return placeholder, ok
+}
+
+var (
+	vcrAccountIDRegexp              = regexache.MustCompile(`\d+`)
+	vcrCallerIdentityRegexp         = regexache.MustCompile(`<Account>(\d{12})</Account>`)
+	vcrAccessKeyIDRegexp            = regexache.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)
+	vcrAccessKeyIDPlaceholderRegexp = regexache.MustCompile(`^AKIA\d{16}$`)
+	vcrSecretElementRegexp          = regexache.MustCompile(`(<(SecretAccessKey|SessionToken)>)[^<]*(</(SecretAccessKey|SessionToken)>)`)
+	vcrSecretAttributeRegexp        = regexache.MustCompile(`("(?:SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`)
+	vcrPresignedURLRegexp           = regexache.MustCompile(`((?i:X-Amz-(?:Security-Token|Signature))(?:=|%3D))[^&"<\s]+`)
+)
+
+// vcrAccountIDRedaction replaces the IDs of the accounts used by the test with placeholders.
+// Accounts are discovered from STS GetCallerIdentity responses, so that other account IDs
+// present in the test configuration, such as AMI owners, are not replaced.
+type vcrAccountIDRedaction struct {
+	accountIDs *vcrPlaceholders
+}
+
+func newVCRAccountIDRedaction() VCRRedaction {
+	return &vcrAccountIDRedaction{
+		accountIDs: newVCRPlaceholders("%012d"),
+	}
+}
+
+func (r *vcrAccountIDRedaction) Discover(i *cassette.Interaction) {
+	if i.Response.Code != http.StatusOK || !strings.Contains(i.Request.Body, "Action=GetCallerIdentity") {
+		return
+	}
+
+	for _, m := range vcrCallerIdentityRegexp.FindAllStringSubmatch(i.Response.Body, -1) {
+		r.accountIDs.add(m[1])
+	}
+}
+
+func (r *vcrAccountIDRedaction) Redact(s string) string {
+	return vcrAccountIDRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		if placeholder, ok := r.accountIDs.get(v); ok {
+			return placeholder
+		}
+		return v
+	})
+}
+
+// vcrAccessKeyIDRedaction replaces AWS access key IDs with placeholders.
+type vcrAccessKeyIDRedaction struct {
+	accessKeyIDs *vcrPlaceholders
+}
+
+func newVCRAccessKeyIDRedaction() VCRRedaction {
+	return &vcrAccessKeyIDRedaction{
+		accessKeyIDs: newVCRPlaceholders("AKIA%016d"),
+	}
+}
+
+func (r *vcrAccessKeyIDRedaction) Discover(*cassette.Interaction) {}
+
+func (r *vcrAccessKeyIDRedaction) Redact(s string) string {
+	return vcrAccessKeyIDRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		// Interactions loaded from an existing cassette have already been redacted.
+		if vcrAccessKeyIDPlaceholderRegexp.MatchString(v) {
+			return v
+		}
+		r.accessKeyIDs.add(v)
+		placeholder, _ := r.accessKeyIDs.get(v)
+		return placeholder
+	})
+}
+
+// vcrSecretRedaction removes secret access keys, session tokens and presigned URL signatures.
+// These values are never sent back to AWS by a test, so are not replaced with stable placeholders.
+type vcrSecretRedaction struct{}
+
+func newVCRSecretRedaction() VCRRedaction {
+	return vcrSecretRedaction{}
+}
+
+func (vcrSecretRedaction) Discover(*cassette.Interaction) {}
+
+func (vcrSecretRedaction) Redact(s string) string {
+	s = vcrSecretElementRegexp.ReplaceAllString(s, "${1}REDACTED${3}")
+	s = vcrSecretAttributeRegexp.ReplaceAllString(s, "${1}REDACTED${2}")
+	s = vcrPresignedURLRegexp.ReplaceAllString(s, "${1}REDACTED")
+
+	return s
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// AccountConfig represents a named AWS account that resources can select via the top-level `provider_account` argument.
+type AccountConfig struct {
+	// AssumeRole is chained after any IAM Roles assumed by the provider configuration.
+	AssumeRole []awsbase.AssumeRole
+	// Region defaults to the provider configuration's Region.
+	Region string
+}
+
+// ValidateInContextAccount verifies that any per-resource named account override is configured and usable.
+func (c *AWSClient) ValidateInContextAccount(ctx context.Context) error {
+	_, _, err := c.accountClientForContext(ctx)
+
+	return err
+}
+
+// IsAccountConfigured returns whether the specified named account is defined in the provider configuration.
+func (c *AWSClient) IsAccountConfigured(name string) bool {
+	_, ok := c.accounts[name]
+
+	return ok
+}
+
+// accountClientForContext returns the AWSClient for any currently in effect per-resource named account override
+// and a Context with that override removed, otherwise the receiver and the unmodified Context.
+func (c *AWSClient) accountClientForContext(ctx context.Context) (*AWSClient, context.Context, error) {
+	if inContext, ok := FromContext(ctx); ok {
+		if name := inContext.OverrideAccount(); name != "" {
+			client, err := c.accountClient(ctx, name)
+
+			return client, WithOverrideAccount(ctx, ""), err
+		}
+	}
+
+	return c, ctx, nil
+}
+
+// accountRegion returns the configured Region for the specified named account.
+func (c *AWSClient) accountRegion(name string) string {
+	if v, ok := c.accounts[name]; ok && v.Region != "" {
+		return v.Region
+	}
+
+	return c.awsConfig.Region
+}
+
+// accountClient returns the AWSClient for the specified named account.
+// The AWSClient is configured on first use and cached. Configuration failures are not cached.
+// Configuring an account, which may assume IAM Roles, blocks only concurrent callers for the same account.
+func (c *AWSClient) accountClient(ctx context.Context, name string) (*AWSClient, error) {
+	c.accountsLock.Lock()
+	if v, ok := c.accountClients[name]; ok {
+		c.accountsLock.Unlock()
+		return v, nil
+	}
+
+	account, ok := c.accounts[name]
+	if !ok || c.providerConfig == nil {
+		c.accountsLock.Unlock()
+		return nil, fmt.Errorf("account (%s) is not defined in the provider configuration", name)
+	}
+
+	if c.accountConfigureLocks == nil {
+		c.accountConfigureLocks = make(map[string]*sync.Mutex)
+	}
+	configureLock, ok := c.accountConfigureLocks[name]
+	if !ok {
+		configureLock = new(sync.Mutex)
+		c.accountConfigureLocks[name] = configureLock
+	}
+	c.accountsLock.Unlock()
+
+	configureLock.Lock()
+	defer configureLock.Unlock()
+
+	// Another caller may have configured the account while this one waited.
+	c.accountsLock.Lock()
+	v, ok := c.accountClients[name]
+	c.accountsLock.Unlock()
+	if ok {
+		return v, nil
+	}
+
+	config := *c.providerConfig
+	config.Accounts = nil
+	config.AssumeRole = append(slices.Clone(config.AssumeRole), account.AssumeRole...)
+	if account.Region != "" {
+		config.Region = account.Region
+	}
+
+	tflog.Debug(ctx, "Configuring AWS account", map[string]any{
+		"tf_aws.account": name,
+	})
+
+	client := new(AWSClient)
+	client.apiAuditLogger = c.apiAuditLogger
+	client.SetHTTPClient(ctx, c.httpClient)
+	client.SetServicePackages(ctx, c.servicePackages)
+
+	client, diags := config.ConfigureProvider(ctx, client)
+	if diags.HasError() {
+		return nil, fmt.Errorf("configuring account (%s): %w", name, sdkdiag.DiagnosticsError(diags))
+	}
+
+	c.accountsLock.Lock()
+	c.accountClients[name] = client
+	c.accountsLock.Unlock()
+
+	return client, nil
+}
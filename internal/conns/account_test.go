@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestAWSClientValidateInContextAccount(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Account   string
+		Expected  bool
+	}{
+		{
+			Name:      "no override",
+			AWSClient: &AWSClient{},
+			Expected:  true,
+		},
+		{
+			Name: "configured account",
+			AWSClient: &AWSClient{
+				accountClients: map[string]*AWSClient{
+					"production": {},
+				},
+				accounts: map[string]AccountConfig{
+					"production": {},
+				},
+			},
+			Account:  "production",
+			Expected: true,
+		},
+		{
+			Name: "unknown account",
+			AWSClient: &AWSClient{
+				accountClients: map[string]*AWSClient{},
+				accounts: map[string]AccountConfig{
+					"production": {},
+				},
+			},
+			Account:  "staging",
+			Expected: false,
+		},
+		{
+			Name:      "no accounts configured",
+			AWSClient: &AWSClient{},
+			Account:   "production",
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "")
+			ctx = WithOverrideAccount(ctx, testCase.Account)
+			err := testCase.AWSClient.ValidateInContextAccount(ctx)
+
+			if got := err == nil; got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionWithAccount(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		accounts: map[string]AccountConfig{
+			"production": {
+				Region: endpoints.EuWest1RegionID,
+			},
+			"staging": {},
+		},
+		awsConfig: &aws.Config{
+			Region: endpoints.UsWest2RegionID,
+		},
+	}
+
+	testCases := []struct {
+		Name           string
+		Account        string
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name:     "no override",
+			Expected: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:     "account with Region",
+			Account:  "production",
+			Expected: endpoints.EuWest1RegionID,
+		},
+		{
+			Name:     "account without Region",
+			Account:  "staging",
+			Expected: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:           "account with Region and Region override",
+			Account:        "production",
+			OverrideRegion: endpoints.ApSoutheast2RegionID,
+			Expected:       endpoints.ApSoutheast2RegionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", testCase.OverrideRegion)
+			ctx = WithOverrideAccount(ctx, testCase.Account)
+
+			if got, want := client.Region(ctx), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientPartitionWithAccount(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	partition := func(id string) endpoints.Partition {
+		for _, v := range endpoints.DefaultPartitions() {
+			if v.ID() == id {
+				return v
+			}
+		}
+		t.Fatalf("partition (%s) not found", id)
+		return endpoints.Partition{}
+	}
+
+	client := &AWSClient{
+		accountClients: map[string]*AWSClient{
+			"china": {
+				partition: partition(endpoints.AwsCnPartitionID),
+			},
+		},
+		accounts: map[string]AccountConfig{
+			"china": {
+				Region: endpoints.CnNorth1RegionID,
+			},
+		},
+		partition: partition(endpoints.AwsPartitionID),
+	}
+
+	testCases := []struct {
+		Name              string
+		Account           string
+		ExpectedPartition string
+		ExpectedDNSSuffix string
+	}{
+		{
+			Name:              "no override",
+			ExpectedPartition: endpoints.AwsPartitionID,
+			ExpectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			Name:              "account override",
+			Account:           "china",
+			ExpectedPartition: endpoints.AwsCnPartitionID,
+			ExpectedDNSSuffix: "amazonaws.com.cn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "")
+			ctx = WithOverrideAccount(ctx, testCase.Account)
+
+			if got, want := client.Partition(ctx), testCase.ExpectedPartition; got != want {
+				t.Errorf("Partition: got %s, expected %s", got, want)
+			}
+			if got, want := client.DNSSuffix(ctx), testCase.ExpectedDNSSuffix; got != want {
+				t.Errorf("DNSSuffix: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientAccountClientConcurrentConfiguration(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	configuring := new(sync.Mutex)
+	client := &AWSClient{
+		accountClients: map[string]*AWSClient{
+			"production": {},
+		},
+		accountConfigureLocks: map[string]*sync.Mutex{
+			"staging": configuring,
+		},
+		accounts: map[string]AccountConfig{
+			"production": {},
+			"staging":    {},
+		},
+	}
+
+	// Simulate a slow configuration of another account, e.g. while assuming an IAM Role.
+	configuring.Lock()
+	defer configuring.Unlock()
+
+	done := make(chan error)
+	go func() {
+		_, err := client.accountClient(t.Context(), "production")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("configuring one account blocked use of another")
+	}
+}
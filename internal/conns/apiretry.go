@@ -4,6 +4,9 @@
 package conns
 
 import (
+	"slices"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -27,3 +30,52 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// ServiceRetryConfig holds the per-service overrides of the provider's API retry and rate limiting configuration.
+type ServiceRetryConfig struct {
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	RequestsPerSecond   int
+	RetryableErrorCodes []string
+}
+
+// withServiceRetryConfig returns a copy of the specified AWS SDK for Go v2 configuration with the per-service overrides applied.
+// The returned configuration is used when constructing all API clients for the service, so any rate limiter is shared by those clients.
+func withServiceRetryConfig(cfg *aws.Config, serviceRetryConfig ServiceRetryConfig) *aws.Config {
+	v := cfg.Copy()
+
+	// The API client constructors wrap the Retryer using the configured maximum number of attempts.
+	if maxAttempts := serviceRetryConfig.MaxAttempts; maxAttempts > 0 {
+		v.RetryMaxAttempts = maxAttempts
+	}
+
+	maxBackoff, errorCodes := serviceRetryConfig.MaxBackoff, serviceRetryConfig.RetryableErrorCodes
+	if newRetryer := cfg.Retryer; newRetryer != nil && (maxBackoff > 0 || len(errorCodes) > 0) {
+		v.Retryer = func() aws.Retryer {
+			r := newRetryer()
+
+			if maxBackoff > 0 {
+				r = retry.AddWithMaxBackoffDelay(r, maxBackoff)
+			}
+
+			if len(errorCodes) > 0 {
+				retryable := retry.RetryableErrorCode{
+					Codes: make(map[string]struct{}, len(errorCodes)),
+				}
+				for _, code := range errorCodes {
+					retryable.Codes[code] = struct{}{}
+				}
+
+				r = AddIsErrorRetryables(r.(aws.RetryerV2), retryable)
+			}
+
+			return r
+		}
+	}
+
+	if requestsPerSecond := serviceRetryConfig.RequestsPerSecond; requestsPerSecond > 0 {
+		v.APIOptions = append(slices.Clone(v.APIOptions), newRequestRateLimiter(requestsPerSecond).addToStack)
+	}
+
+	return &v
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
@@ -93,3 +94,104 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestWithServiceRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws.Config{
+		RetryMaxAttempts: 25,
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard()
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		serviceRetryConfig    ServiceRetryConfig
+		expectedMaxAttempts   int
+		expectedAPIOptionsLen int
+		expectedCodeRetryable bool
+		expectedMaxRetryDelay time.Duration
+		errorCode             string
+	}{
+		{
+			name:                "no overrides",
+			expectedMaxAttempts: 25,
+			errorCode:           "ConcurrentModificationException",
+		},
+		{
+			name: "max attempts",
+			serviceRetryConfig: ServiceRetryConfig{
+				MaxAttempts: 5,
+			},
+			expectedMaxAttempts: 5,
+		},
+		{
+			name: "retryable error codes",
+			serviceRetryConfig: ServiceRetryConfig{
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			expectedMaxAttempts:   25,
+			expectedCodeRetryable: true,
+			errorCode:             "ConcurrentModificationException",
+		},
+		{
+			name: "max backoff",
+			serviceRetryConfig: ServiceRetryConfig{
+				MaxBackoff: 2 * time.Second,
+			},
+			expectedMaxAttempts:   25,
+			expectedMaxRetryDelay: 2 * time.Second,
+		},
+		{
+			name: "requests per second",
+			serviceRetryConfig: ServiceRetryConfig{
+				RequestsPerSecond: 5,
+			},
+			expectedMaxAttempts:   25,
+			expectedAPIOptionsLen: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := withServiceRetryConfig(cfg, testCase.serviceRetryConfig)
+
+			if got, want := got.RetryMaxAttempts, testCase.expectedMaxAttempts; got != want {
+				t.Errorf("RetryMaxAttempts = %v, want %v", got, want)
+			}
+
+			if got, want := len(got.APIOptions), testCase.expectedAPIOptionsLen; got != want {
+				t.Errorf("len(APIOptions) = %v, want %v", got, want)
+			}
+
+			retryer := got.Retryer()
+
+			if code := testCase.errorCode; code != "" {
+				err := &smithy.GenericAPIError{Code: code}
+				if got, want := retryer.IsErrorRetryable(err), testCase.expectedCodeRetryable; got != want {
+					t.Errorf("IsErrorRetryable(%q) = %v, want %v", code, got, want)
+				}
+			}
+
+			if want := testCase.expectedMaxRetryDelay; want > 0 {
+				for attempt := 1; attempt < 10; attempt++ {
+					delay, err := retryer.RetryDelay(attempt, errors.New("test"))
+					if err != nil {
+						t.Fatalf("RetryDelay(%d): %s", attempt, err)
+					}
+					if delay > want {
+						t.Errorf("RetryDelay(%d) = %v, want <= %v", attempt, delay, want)
+					}
+				}
+			}
+		})
+	}
+
+	// The provider-level configuration is not modified.
+	if got, want := cfg.RetryMaxAttempts, 25; got != want {
+		t.Errorf("provider RetryMaxAttempts = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// AuditLogFileEnvVar is the name of the environment variable that can be used to set the API call audit log file.
+	AuditLogFileEnvVar = "TF_AWS_AUDIT_LOG_FILE"
+)
+
+// apiAuditLogger appends one JSON object per AWS API call to an audit log.
+// Only call metadata is recorded. Request and response bodies, headers and error messages,
+// any of which may contain secrets, are never written.
+type apiAuditLogger struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// apiAuditRecord is a single audit log entry.
+type apiAuditRecord struct {
+	Time               time.Time `json:"time"`
+	AccountID          string    `json:"account_id,omitempty"`
+	Region             string    `json:"region,omitempty"`
+	Service            string    `json:"service"`
+	Operation          string    `json:"operation"`
+	ServicePackageName string    `json:"service_package,omitempty"`
+	ResourceType       string    `json:"resource_type,omitempty"`
+	ResourceName       string    `json:"resource_name,omitempty"`
+	RequestID          string    `json:"request_id,omitempty"`
+	LatencyMillis      int64     `json:"latency_ms"`
+	Attempts           int       `json:"attempts"`
+	Retries            int       `json:"retries"`
+	Throttles          int       `json:"throttles"`
+	Failed             bool      `json:"failed"`
+	ErrorCode          string    `json:"error_code,omitempty"`
+}
+
+// newAPIAuditLogger opens the specified file for appending, creating it if necessary.
+// The file is shared by all API clients for the lifetime of the provider process.
+func newAPIAuditLogger(path string) (*apiAuditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening API audit log file (%s): %w", path, err)
+	}
+
+	return &apiAuditLogger{
+		w: f,
+	}, nil
+}
+
+// write appends the specified record to the audit log.
+func (l *apiAuditLogger) write(record apiAuditRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	_, err = l.w.Write(b)
+
+	return err
+}
+
+// addToStack returns a function that adds the audit logging middleware to an API operation's middleware stack.
+// The middleware runs at the end of the Initialize step so that service metadata is available and all attempts are included.
+func (l *apiAuditLogger) addToStack(accountID string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAPIAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			record := apiAuditRecord{
+				Time:          start.UTC(),
+				AccountID:     accountID,
+				Region:        awsmiddleware.GetRegion(ctx),
+				Service:       awsmiddleware.GetServiceID(ctx),
+				Operation:     awsmiddleware.GetOperationName(ctx),
+				LatencyMillis: time.Since(start).Milliseconds(),
+			}
+			if inContext, ok := FromContext(ctx); ok {
+				record.ServicePackageName = inContext.ServicePackageName()
+				record.ResourceType = inContext.TypeName()
+				record.ResourceName = inContext.ResourceName()
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				record.RequestID = v
+			}
+			if v, ok := retry.GetAttemptResults(metadata); ok {
+				record.Attempts = len(v.Results)
+				throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+				for _, result := range v.Results {
+					if result.Err != nil && throttles.IsErrorThrottle(result.Err).Bool() {
+						record.Throttles++
+					}
+				}
+			}
+			if record.Attempts > 0 {
+				record.Retries = record.Attempts - 1
+			}
+			// Error messages are not recorded as they may contain sensitive values.
+			if err != nil {
+				record.Failed = true
+				if apiErr, ok := errs.As[smithy.APIError](err); ok {
+					record.ErrorCode = apiErr.ErrorCode()
+				}
+			}
+
+			// Failure to write the audit log never fails the API call.
+			if err := l.write(record); err != nil {
+				tflog.Warn(ctx, "writing API audit log", map[string]any{
+					"error": err.Error(),
+				})
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAPIAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		handlerErr     error
+		ctx            func(context.Context) context.Context
+		expectedRecord apiAuditRecord
+	}{
+		"success": {
+			ctx: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			expectedRecord: apiAuditRecord{
+				AccountID: "123456789012",
+				Service:   "SQS",
+				RequestID: "request-1",
+			},
+		},
+		"resource context": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, names.SQS, "Queue", "aws_sqs_queue", "")
+			},
+			expectedRecord: apiAuditRecord{
+				AccountID:          "123456789012",
+				Service:            "SQS",
+				ServicePackageName: names.SQS,
+				ResourceType:       "aws_sqs_queue",
+				ResourceName:       "Queue",
+				RequestID:          "request-1",
+			},
+		},
+		"API error": {
+			handlerErr: &smithy.GenericAPIError{Code: "AccessDenied", Message: "secret"},
+			ctx: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			expectedRecord: apiAuditRecord{
+				AccountID: "123456789012",
+				Service:   "SQS",
+				RequestID: "request-1",
+				Failed:    true,
+				ErrorCode: "AccessDenied",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger := &apiAuditLogger{w: &buf}
+
+			stack := middleware.NewStack("test", func() any { return nil })
+			if err := logger.addToStack("123456789012")(stack); err != nil {
+				t.Fatalf("adding to stack: %s", err)
+			}
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				var metadata middleware.Metadata
+				awsmiddleware.SetRequestIDMetadata(&metadata, "request-1")
+				return nil, metadata, testCase.handlerErr
+			}), stack)
+
+			ctx := testCase.ctx(awsmiddleware.SetServiceID(t.Context(), "SQS"))
+			if _, _, err := handler.Handle(ctx, nil); err != testCase.handlerErr { //nolint:errorlint // The error must be returned unwrapped
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got apiAuditRecord
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("unmarshaling audit record: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedRecord, cmpopts.IgnoreFields(apiAuditRecord{}, "Time", "LatencyMillis")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if bytes.Contains(buf.Bytes(), []byte("secret")) {
+				t.Errorf("audit record contains error message: %s", buf.String())
+			}
+		})
+	}
+}
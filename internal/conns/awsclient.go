@@ -22,28 +22,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	servicePackages           map[string]ServicePackage
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
-	tagPolicyConfig           *tftags.TagPolicyConfig
-	terraformVersion          string // From provider configuration.
+	accountClients             map[string]*AWSClient  // Account name -> lazily configured API clients.
+	accountConfigureLocks      map[string]*sync.Mutex // Account name -> lock held while configuring the account.
+	accountID                  string
+	accounts                   map[string]AccountConfig // From provider configuration.
+	accountsLock               sync.Mutex
+	apiAuditLogger             *apiAuditLogger // Shared with named account clients.
+	awsConfig                  *aws.Config
+	clients                    map[string]map[string]any // Region -> service package name -> API client.
+	defaultTagsConfig          *tftags.DefaultConfig
+	endpoints                  map[string]string // From provider configuration.
+	httpClient                 *http.Client
+	ignoreTagsConfig           *tftags.IgnoreConfig
+	lock                       sync.Mutex
+	logger                     baselogging.Logger
+	partition                  endpoints.Partition
+	permissionSimulationConfig *permissionsim.Config
+	providerConfig             *Config // Used to configure named accounts.
+	servicePackages            map[string]ServicePackage
+	s3ExpressClient            *s3.Client
+	s3UsePathStyle             bool                   // From provider configuration.
+	s3USEast1RegionalEndpoint  string                 // From provider configuration.
+	serviceAWSConfigs          map[string]*aws.Config // Service package name -> AWS SDK configuration with per-service overrides.
+	stsRegion                  string                 // From provider configuration.
+	tagPolicyConfig            *tftags.TagPolicyConfig
+	terraformVersion           string // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -67,8 +76,11 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
-	if c.awsConfig == nil {
+// If the currently in-process operation has defined a per-resource named account override,
+// that account's credentials provider is returned.
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
+	c, _, err := c.accountClientForContext(ctx)
+	if err != nil || c.awsConfig == nil {
 		return nil
 	}
 	return c.awsConfig.Credentials
@@ -86,28 +98,67 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// PermissionSimulationConfig returns any plan-time IAM permission simulation configuration.
+// If the currently in-process operation has defined a per-resource named account override,
+// that account's configuration is returned.
+func (c *AWSClient) PermissionSimulationConfig(ctx context.Context) *permissionsim.Config {
+	c, _, err := c.accountClientForContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return c.permissionSimulationConfig
+}
+
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// If the currently in-process operation has defined a per-resource named account override,
+// that account's configuration is returned. If the account cannot be configured, the returned configuration has no credentials.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	v, _, err := c.accountClientForContext(ctx)
+	if err != nil {
+		// Never fall back to the provider's credentials.
+		cfg := c.awsConfig.Copy()
+		cfg.Credentials = nil
+		cfg.Region = c.Region(ctx)
+		return cfg
+	}
+
+	return v.awsConfig.Copy()
 }
 
 // AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// If the currently in-process operation has defined a per-resource named account override,
+// that account's ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	c, _, err := c.accountClientForContext(ctx)
+	if err != nil {
+		return ""
+	}
 	return c.accountID
 }
 
 // Partition returns the ID of the configured AWS partition.
-func (c *AWSClient) Partition(context.Context) string {
+// If the currently in-process operation has defined a per-resource named account override,
+// that account's partition is returned.
+func (c *AWSClient) Partition(ctx context.Context) string {
+	c, _, err := c.accountClientForContext(ctx)
+	if err != nil {
+		return ""
+	}
 	return c.partition.ID()
 }
 
 // Region returns the ID of the effective AWS Region.
 // If the currently in-process operation has defined a per-resource Region override,
-// that value is returned, otherwise the configured Region is returned.
+// that value is returned, otherwise the configured Region of any per-resource named account override
+// or the provider's configured Region is returned.
 func (c *AWSClient) Region(ctx context.Context) string {
 	if inContext, ok := FromContext(ctx); ok {
 		if r := inContext.OverrideRegion(); r != "" {
 			return r
 		}
+		if a := inContext.OverrideAccount(); a != "" {
+			return c.accountRegion(a)
+		}
 	}
 
 	return c.awsConfig.Region
@@ -177,6 +228,10 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
+	if v, ctx, err := c.accountClientForContext(ctx); err == nil && v != c {
+		return v.S3ExpressClient(ctx)
+	}
+
 	s3Client := c.S3Client(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
@@ -274,7 +329,13 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
 }
 
 // DNSSuffix returns the domain suffix for the configured AWS partition.
-func (c *AWSClient) DNSSuffix(context.Context) string {
+// If the currently in-process operation has defined a per-resource named account override,
+// that account's partition is used.
+func (c *AWSClient) DNSSuffix(ctx context.Context) string {
+	if v, _, err := c.accountClientForContext(ctx); err == nil {
+		c = v
+	}
+
 	dnsSuffix := c.partition.DNSSuffix()
 	if dnsSuffix == "" {
 		dnsSuffix = "amazonaws.com"
@@ -337,8 +398,13 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if v, ok := c.serviceAWSConfigs[servicePackageName]; ok {
+		awsConfig = v
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -363,6 +429,14 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
+	// Any per-resource named account override is handled by that account's AWSClient.
+	if v, ctx, err := c.accountClientForContext(ctx); err != nil {
+		var zero T
+		return zero, err
+	} else if v != c {
+		return client[T](ctx, v, servicePackageName, extra)
+	}
+
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 	region := c.Region(ctx)
 
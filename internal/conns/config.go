@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -28,9 +29,11 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	Accounts                       map[string]AccountConfig // Account name -> account configuration.
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogFile                   string // Path of the file to which a JSON line is appended per AWS API call.
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -44,12 +47,15 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PermissionSimulation           string // Severity of plan-time IAM permission simulation findings.
 	Profile                        string
+	ReadCacheTTL                   time.Duration // Time-to-live of cached read API results. Zero disables the cache.
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRetry                   map[string]ServiceRetryConfig // Service package name -> retry configuration overrides.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -193,6 +199,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	// Serve repeated read API calls made while reading resources and data sources from the cache.
+	// The cache runs before the audit log so that cached results are not recorded as API calls.
+	if c.ReadCacheTTL > 0 {
+		cfg.APIOptions = append(cfg.APIOptions, newAPIReadCache(c.ReadCacheTTL).addToStack)
+	}
+
+	// Record all subsequent API calls in the audit log.
+	if c.AuditLogFile != "" {
+		if client.apiAuditLogger == nil {
+			apiAuditLogger, err := newAPIAuditLogger(c.AuditLogFile)
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+			client.apiAuditLogger = apiAuditLogger
+		}
+		cfg.APIOptions = append(cfg.APIOptions, client.apiAuditLogger.addToStack(accountID))
+	}
+
 	// Fetch tag policy details when enforced
 	if c.TagPolicyConfig != nil {
 		tflog.Debug(ctx, "Retrieving tag policy details")
@@ -208,7 +232,27 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		c.TagPolicyConfig.RequiredTags = reqTags
 	}
 
+	// Resolve the principal whose IAM policies are simulated during plan.
+	if c.PermissionSimulation != "" {
+		tflog.Debug(ctx, "Configuring IAM permission simulation")
+		permissionSimulationConfig, err := permissionsim.NewConfig(ctx, cfg, c.PermissionSimulation)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Configuring IAM Permission Simulation",
+				`Failed to determine the IAM principal whose permissions are simulated. Ensure the calling principal `+
+					`is an IAM user or role and has the "iam:GetRole" and "iam:SimulatePrincipalPolicy" IAM permissions.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+		client.permissionSimulationConfig = permissionSimulationConfig
+	}
+
 	client.accountID = accountID
+	if len(c.Accounts) > 0 {
+		client.accountClients = make(map[string]*AWSClient, len(c.Accounts))
+		client.accounts = c.Accounts
+		client.providerConfig = c
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
@@ -221,6 +265,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceAWSConfigs = make(map[string]*aws.Config, len(c.ServiceRetry))
+	for servicePackageName, serviceRetryConfig := range c.ServiceRetry {
+		client.serviceAWSConfigs[servicePackageName] = withServiceRetryConfig(&cfg, serviceRetryConfig)
+	}
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -50,11 +50,13 @@ type (
 )
 
 var (
-	contextKey contextKeyType
+	contextKey          contextKeyType
+	readCacheContextKey contextKeyType = 1
 )
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
+	overrideAccount    string // Any currently in effect per-resource named account override.
 	overrideRegion     string // Any currently in effect per-resource Region override.
 	resourceName       string // Friendly resource name, e.g. "Subnet"
 	typeName           string // Resource type name, e.g. "aws_iam_role"
@@ -62,6 +64,11 @@ type InContext struct {
 	vcrEnabled         bool   // Whether VCR testing is enabled
 }
 
+// OverrideAccount returns any currently in effect per-resource named account override.
+func (c *InContext) OverrideAccount() string {
+	return c.overrideAccount
+}
+
 // OverrideRegion returns any currently in effect per-resource Region override.
 func (c *InContext) OverrideRegion() string {
 	return c.overrideRegion
@@ -99,6 +106,17 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, t
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// WithOverrideAccount returns a copy of the resource information in Context with the specified per-resource named account override.
+func WithOverrideAccount(ctx context.Context, overrideAccount string) context.Context {
+	var v InContext
+	if inContext, ok := FromContext(ctx); ok {
+		v = *inContext
+	}
+	v.overrideAccount = overrideAccount
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
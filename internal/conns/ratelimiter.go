@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// requestRateLimiter is a client-side limiter of the rate at which AWS API requests are sent.
+// Requests are spaced evenly, with no allowance for bursts.
+type requestRateLimiter struct {
+	interval time.Duration
+	lock     sync.Mutex
+	next     time.Time
+}
+
+func newRequestRateLimiter(requestsPerSecond int) *requestRateLimiter {
+	return &requestRateLimiter{
+		interval: time.Second / time.Duration(requestsPerSecond),
+	}
+}
+
+// wait blocks until a request may be sent or the Context is done.
+func (l *requestRateLimiter) wait(ctx context.Context) error {
+	l.lock.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// addToStack adds the rate limiting middleware to an API operation's middleware stack.
+// The middleware runs after the retry middleware so that every attempt is rate limited.
+func (l *requestRateLimiter) addToStack(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("TerraformRequestRateLimiter", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), "Retry", middleware.After)
+}
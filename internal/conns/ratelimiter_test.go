@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRequestRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := newRequestRateLimiter(20)
+
+	start := time.Now()
+	for range 5 {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("wait: %s", err)
+		}
+	}
+
+	// The first request is immediate, the remaining 4 are spaced 50ms apart.
+	if got, want := time.Since(start), 200*time.Millisecond; got < want {
+		t.Errorf("elapsed = %v, want >= %v", got, want)
+	}
+}
+
+func TestRequestRateLimiterWaitContextDone(t *testing.T) {
+	t.Parallel()
+
+	l := newRequestRateLimiter(1)
+
+	if err := l.wait(t.Context()); err != nil {
+		t.Fatalf("wait: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
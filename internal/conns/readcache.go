@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// ReadCacheTTLEnvVar is the name of the environment variable that can be used to set the API read cache time-to-live.
+	ReadCacheTTLEnvVar = "TF_AWS_READ_CACHE_TTL"
+)
+
+// WithReadCache returns a Context in which the results of idempotent read API calls may be served from the AWSClient's read cache.
+// It is used to scope the cache to resource and data source reads, so that waiters polling for a state change are never served cached results.
+func WithReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readCacheContextKey, true)
+}
+
+func readCacheEnabled(ctx context.Context) bool {
+	v, _ := ctx.Value(readCacheContextKey).(bool)
+	return v
+}
+
+// apiReadCache is a client-side cache of the results of idempotent read (Describe*, Get* and List*) API calls.
+// Read operations that return a different result on each call, such as generating a random password, are never cached.
+// Any other call to a service invalidates all cached results for that service.
+type apiReadCache struct {
+	entries     map[string]apiReadCacheEntry // Cache key -> cached result.
+	generations map[string]uint64            // Service ID -> invalidation generation.
+	lastPruned  time.Time
+	lock        sync.Mutex
+	ttl         time.Duration
+}
+
+type apiReadCacheEntry struct {
+	expires    time.Time
+	generation uint64
+	result     any
+	serviceID  string
+}
+
+func newAPIReadCache(ttl time.Duration) *apiReadCache {
+	return &apiReadCache{
+		entries:     make(map[string]apiReadCacheEntry),
+		generations: make(map[string]uint64),
+		lastPruned:  time.Now(),
+		ttl:         ttl,
+	}
+}
+
+// get returns any unexpired cached result for the specified key, together with the service's current invalidation generation.
+func (c *apiReadCache) get(serviceID, key string) (any, uint64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	generation := c.generations[serviceID]
+	if v, ok := c.entries[key]; ok && v.generation == generation && time.Now().Before(v.expires) {
+		return v.result, generation, true
+	}
+
+	return nil, generation, false
+}
+
+// put caches the specified result unless the service has been invalidated since the specified generation.
+func (c *apiReadCache) put(serviceID, key string, generation uint64, result any) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.generations[serviceID] != generation {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(c.lastPruned) > c.ttl {
+		for k, v := range c.entries {
+			if v.generation != c.generations[v.serviceID] || !now.Before(v.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastPruned = now
+	}
+
+	c.entries[key] = apiReadCacheEntry{
+		expires:    now.Add(c.ttl),
+		generation: generation,
+		result:     result,
+		serviceID:  serviceID,
+	}
+}
+
+// invalidate invalidates all cached results for the specified service.
+func (c *apiReadCache) invalidate(serviceID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.generations[serviceID]++
+}
+
+// addToStack adds the read cache middleware to an API operation's middleware stack.
+// The middleware runs at the end of the Initialize step so that service metadata is available.
+func (c *apiReadCache) addToStack(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAPIReadCache", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+
+		if !isReadOperation(operation) {
+			// Invalidate both before and after the call so that no concurrent read caches a result from before the call completed.
+			c.invalidate(serviceID)
+			defer c.invalidate(serviceID)
+
+			return next.HandleInitialize(ctx, in)
+		}
+
+		if !readCacheEnabled(ctx) || !isCacheableOperation(operation) {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		key, ok := readCacheKey(ctx, serviceID, operation, in.Parameters)
+		if !ok {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		// Callers may modify results, so each caller is given its own copy.
+		result, generation, ok := c.get(serviceID, key)
+		if ok {
+			return middleware.InitializeOutput{Result: deepCopy(result)}, middleware.Metadata{}, nil
+		}
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		if err == nil && isCacheableResult(out.Result) {
+			c.put(serviceID, key, generation, deepCopy(out.Result))
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// isReadOperation returns whether the specified API operation is assumed to be idempotent and free of side effects.
+func isReadOperation(operation string) bool {
+	for _, prefix := range []string{"Describe", "Get", "List"} {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// uncacheableReadOperations are read operations that return a different result on each call,
+// typically newly generated random values or credentials.
+var uncacheableReadOperations = map[string]bool{
+	"GetAuthorizationToken":              true, // ECR, ECR Public, CodeArtifact.
+	"GetClusterCredentials":              true, // Redshift.
+	"GetClusterCredentialsWithIAM":       true, // Redshift.
+	"GetCredentials":                     true, // Redshift Serverless.
+	"GetCredentialsForIdentity":          true, // Cognito Identity.
+	"GetFederationToken":                 true, // STS.
+	"GetId":                              true, // Cognito Identity.
+	"GetOpenIdToken":                     true, // Cognito Identity.
+	"GetOpenIdTokenForDeveloperIdentity": true, // Cognito Identity.
+	"GetRandomPassword":                  true, // Secrets Manager.
+	"GetRecords":                         true, // Kinesis, DynamoDB Streams.
+	"GetRoleCredentials":                 true, // SSO.
+	"GetSessionToken":                    true, // STS.
+	"GetShardIterator":                   true, // Kinesis, DynamoDB Streams.
+	"GetWebIdentityToken":                true, // STS.
+}
+
+// isCacheableOperation returns whether the results of the specified read API operation can be cached.
+func isCacheableOperation(operation string) bool {
+	return !uncacheableReadOperations[operation]
+}
+
+// readCacheKey returns the cache key for the specified API call.
+// Inputs that cannot be serialized are not cached.
+func readCacheKey(ctx context.Context, serviceID, operation string, input any) (string, bool) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%T/%s", serviceID, awsmiddleware.GetRegion(ctx), operation, input, b), true
+}
+
+// isCacheableResult returns whether the specified API result can be returned to more than one caller.
+// Results containing streaming bodies are consumed by their first reader, so are never cached.
+func isCacheableResult(result any) bool {
+	v := reflect.Indirect(reflect.ValueOf(result))
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	reader := reflect.TypeFor[io.Reader]()
+	for i := range v.NumField() {
+		if t := v.Type().Field(i).Type; t.Kind() == reflect.Interface && t.Implements(reader) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deepCopy returns a copy of the specified API result that shares no exported pointers, slices, maps or interfaces with it.
+// Unexported fields, such as those holding response metadata, are copied shallowly.
+func deepCopy(v any) any {
+	if v == nil {
+		return nil
+	}
+
+	return deepCopyValue(reflect.ValueOf(v)).Interface()
+}
+
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(deepCopyValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type readCacheTestInput struct {
+	Name string
+}
+
+type readCacheTestOutput struct {
+	Value int
+}
+
+type readCacheTestStreamingOutput struct {
+	Body io.ReadCloser
+}
+
+func TestAPIReadCache(t *testing.T) {
+	t.Parallel()
+
+	cache := newAPIReadCache(time.Minute)
+	calls := 0
+
+	invoke := func(ctx context.Context, operation string, input any) any {
+		t.Helper()
+
+		stack := middleware.NewStack(operation, func() any { return nil })
+		stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+			ServiceID:     "Test",
+			OperationName: operation,
+		}, middleware.Before)
+		if err := cache.addToStack(stack); err != nil {
+			t.Fatalf("adding to stack: %s", err)
+		}
+
+		// Results are set by the Deserialize step.
+		stack.Deserialize.Add(middleware.DeserializeMiddlewareFunc("Result", func(context.Context, middleware.DeserializeInput, middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+			calls++
+			if operation == "GetObject" {
+				return middleware.DeserializeOutput{Result: &readCacheTestStreamingOutput{Body: io.NopCloser(strings.NewReader(""))}}, middleware.Metadata{}, nil
+			}
+			return middleware.DeserializeOutput{Result: &readCacheTestOutput{Value: calls}}, middleware.Metadata{}, nil
+		}), middleware.After)
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+			return nil, middleware.Metadata{}, nil
+		}), stack)
+
+		result, _, err := handler.Handle(ctx, input)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return result
+	}
+
+	ctx := WithReadCache(t.Context())
+
+	first := invoke(ctx, "DescribeThing", &readCacheTestInput{Name: "a"}).(*readCacheTestOutput)
+	second := invoke(ctx, "DescribeThing", &readCacheTestInput{Name: "a"}).(*readCacheTestOutput)
+	if got, want := calls, 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+	if second == first || second.Value != first.Value {
+		t.Errorf("expected copy of cached result %v, got %v", first, second)
+	}
+
+	// Modifying a result doesn't modify the cached result.
+	second.Value = -1
+	if got := invoke(ctx, "DescribeThing", &readCacheTestInput{Name: "a"}).(*readCacheTestOutput); got.Value != first.Value {
+		t.Errorf("expected unmodified cached result %v, got %v", first, got)
+	}
+
+	invoke(ctx, "DescribeThing", &readCacheTestInput{Name: "b"})
+	if got, want := calls, 2; got != want {
+		t.Errorf("different input: calls = %d, want %d", got, want)
+	}
+
+	invoke(t.Context(), "DescribeThing", &readCacheTestInput{Name: "a"})
+	if got, want := calls, 3; got != want {
+		t.Errorf("cache not enabled in Context: calls = %d, want %d", got, want)
+	}
+
+	invoke(ctx, "UpdateThing", &readCacheTestInput{Name: "a"})
+	invoke(ctx, "DescribeThing", &readCacheTestInput{Name: "a"})
+	if got, want := calls, 5; got != want {
+		t.Errorf("after mutating call: calls = %d, want %d", got, want)
+	}
+
+	invoke(ctx, "GetObject", &readCacheTestInput{Name: "a"})
+	invoke(ctx, "GetObject", &readCacheTestInput{Name: "a"})
+	if got, want := calls, 7; got != want {
+		t.Errorf("streaming result: calls = %d, want %d", got, want)
+	}
+
+	invoke(ctx, "GetRandomPassword", &readCacheTestInput{Name: "a"})
+	invoke(ctx, "GetRandomPassword", &readCacheTestInput{Name: "a"})
+	if got, want := calls, 9; got != want {
+		t.Errorf("uncacheable operation: calls = %d, want %d", got, want)
+	}
+	invoke(ctx, "DescribeThing", &readCacheTestInput{Name: "a"})
+	if got, want := calls, 9; got != want {
+		t.Errorf("after uncacheable operation: calls = %d, want %d", got, want)
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Values []string
+	}
+	type output struct {
+		Map    map[string]*nested
+		Nested *nested
+		Union  any
+	}
+
+	original := &output{
+		Map:    map[string]*nested{"k": {Values: []string{"a"}}},
+		Nested: &nested{Values: []string{"b"}},
+		Union:  &nested{Values: []string{"c"}},
+	}
+
+	c := deepCopy(original).(*output)
+	c.Map["k"].Values[0] = "x"
+	c.Nested.Values[0] = "y"
+	c.Union.(*nested).Values[0] = "z"
+
+	if got, want := original.Map["k"].Values[0], "a"; got != want {
+		t.Errorf("map: got %q, want %q", got, want)
+	}
+	if got, want := original.Nested.Values[0], "b"; got != want {
+		t.Errorf("pointer: got %q, want %q", got, want)
+	}
+	if got, want := original.Union.(*nested).Values[0], "c"; got != want {
+		t.Errorf("interface: got %q, want %q", got, want)
+	}
+}
+
+func TestIsReadOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeSecurityGroups": true,
+		"GetQueueAttributes":     true,
+		"ListTagsForResource":    true,
+		"CreateQueue":            false,
+		"TagResource":            false,
+		"DeleteQueue":            false,
+	}
+
+	for operation, want := range testCases {
+		if got := isReadOperation(operation); got != want {
+			t.Errorf("isReadOperation(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
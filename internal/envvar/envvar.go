@@ -78,6 +78,14 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running tests against a local AWS stand-in, such as a moto server, instead of AWS.
+	// The URL of the stand-in, to which every service client sends requests
+	StandInEndpoint = "TF_ACC_STAND_IN_ENDPOINT"
+
+	// For running tests against a local AWS stand-in, a comma-separated list of the services it supports.
+	// Defaults to the services reported by the stand-in
+	StandInServices = "TF_ACC_STAND_IN_SERVICES"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -96,6 +104,26 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to select the resources deleted by resource sweepers
+const (
+	// If set to a true value, sweepers report the resources that would be deleted instead of deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of name prefixes. Only resources whose names begin with one of the prefixes are swept
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Comma-separated list of tags, either key=value or key to match any value.
+	// Only resources with all of the tags are swept
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// Minimum age, as a duration such as 2h. Only resources created at least this long ago are swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// The path of the file to which a JSON Lines report of the resources selected by sweepers is written.
+	// Dry runs write the report to standard output by default
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command capabilities reports the capabilities of every resource type implemented by the provider
+// as JSON or CSV, using the metadata registered by each service package.
+//
+// Run from the repository root:
+//
+//	go run ./internal/generate/capabilities -format csv -o capabilities.csv
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+)
+
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+const (
+	implementationFramework = "framework"
+	implementationSDK       = "sdkv2"
+)
+
+var (
+	format             = flag.String("format", formatJSON, "output format: json or csv")
+	output             = flag.String("o", "", "output file (default standard output)")
+	servicePackageRoot = flag.String("ServicePackageRoot", "internal/service", "path to service package root directory")
+)
+
+// resourceCapabilities describes the capabilities of a single resource type.
+type resourceCapabilities struct {
+	TypeName            string   `json:"type_name"`
+	ServicePackage      string   `json:"service_package"`
+	Name                string   `json:"name"`
+	Implementation      string   `json:"implementation"`
+	Identity            bool     `json:"identity"`
+	ImportByIdentity    bool     `json:"import_by_identity"`
+	List                bool     `json:"list"`
+	EnhancedRegion      bool     `json:"enhanced_region"`
+	Tags                bool     `json:"tags"`
+	TagPolicyTypes      []string `json:"tag_policy_types,omitempty"`
+	WriteOnlyAttributes []string `json:"write_only_attributes,omitempty"`
+	Sweeper             bool     `json:"sweeper"`
+	VCR                 bool     `json:"vcr"`
+}
+
+func main() {
+	flag.Parse()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	if *format != formatCSV && *format != formatJSON {
+		g.Fatalf("unsupported format: %s", *format)
+	}
+
+	registerSweepers()
+	sweepers := make(map[string]bool)
+	for _, name := range sweep.SweeperNames() {
+		sweepers[name] = true
+	}
+
+	tagPolicyTypes := make(map[string][]string)
+	for tagPolicyType, typeName := range tagpolicy.Lookup {
+		tagPolicyTypes[typeName] = append(tagPolicyTypes[typeName], tagPolicyType)
+	}
+	for _, v := range tagPolicyTypes {
+		slices.Sort(v)
+	}
+
+	var resources []resourceCapabilities
+	for _, sp := range servicePackages(ctx) {
+		servicePackageName := sp.ServicePackageName()
+
+		vcr, err := isVCREnabled(filepath.Join(*servicePackageRoot, servicePackageName))
+		if err != nil {
+			g.Fatalf("reading service package %s: %s", servicePackageName, err)
+		}
+
+		listTypeNames := make(map[string]bool)
+		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			for spec := range v.FrameworkListResources(ctx) {
+				listTypeNames[spec.TypeName] = true
+			}
+		}
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			for spec := range v.SDKListResources(ctx) {
+				listTypeNames[spec.TypeName] = true
+			}
+		}
+
+		for _, spec := range sp.FrameworkResources(ctx) {
+			r, err := spec.Factory(ctx)
+			if err != nil {
+				g.Fatalf("creating resource %s: %s", spec.TypeName, err)
+			}
+
+			var response resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &response)
+			if response.Diagnostics.HasError() {
+				g.Fatalf("getting resource %s schema: %v", spec.TypeName, response.Diagnostics)
+			}
+
+			_, importer := r.(resource.ResourceWithImportState)
+			identity := hasIdentity(spec.Identity)
+
+			resources = append(resources, resourceCapabilities{
+				TypeName:            spec.TypeName,
+				ServicePackage:      servicePackageName,
+				Name:                spec.Name,
+				Implementation:      implementationFramework,
+				Identity:            identity,
+				ImportByIdentity:    identity && importer,
+				List:                listTypeNames[spec.TypeName],
+				EnhancedRegion:      isRegionOverrideEnabled(spec.Region),
+				Tags:                !tfunique.IsHandleNil(spec.Tags),
+				TagPolicyTypes:      tagPolicyTypes[spec.TypeName],
+				WriteOnlyAttributes: frameworkWriteOnlyAttributes("", response.Schema.Attributes, response.Schema.Blocks),
+				Sweeper:             sweepers[spec.TypeName],
+				VCR:                 vcr,
+			})
+		}
+
+		for _, spec := range sp.SDKResources(ctx) {
+			r := spec.Factory()
+			identity := hasIdentity(spec.Identity)
+
+			resources = append(resources, resourceCapabilities{
+				TypeName:            spec.TypeName,
+				ServicePackage:      servicePackageName,
+				Name:                spec.Name,
+				Implementation:      implementationSDK,
+				Identity:            identity,
+				ImportByIdentity:    identity && r.Importer != nil,
+				List:                listTypeNames[spec.TypeName],
+				EnhancedRegion:      isRegionOverrideEnabled(spec.Region),
+				Tags:                !tfunique.IsHandleNil(spec.Tags),
+				TagPolicyTypes:      tagPolicyTypes[spec.TypeName],
+				WriteOnlyAttributes: sdkWriteOnlyAttributes("", r.SchemaMap()),
+				Sweeper:             sweepers[spec.TypeName],
+				VCR:                 vcr,
+			})
+		}
+	}
+
+	slices.SortFunc(resources, func(a, b resourceCapabilities) int {
+		return strings.Compare(a.TypeName, b.TypeName)
+	})
+
+	var buf bytes.Buffer
+	var err error
+	switch *format {
+	case formatCSV:
+		err = writeCSV(&buf, resources)
+	case formatJSON:
+		err = writeJSON(&buf, resources)
+	}
+	if err != nil {
+		g.Fatalf("writing %s: %s", *format, err)
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+	} else {
+		err = os.WriteFile(*output, buf.Bytes(), 0644) //nolint:gosec // Report is not sensitive
+	}
+	if err != nil {
+		g.Fatalf("writing output: %s", err)
+	}
+}
+
+func hasIdentity(identity inttypes.Identity) bool {
+	return len(identity.Attributes) > 0
+}
+
+func isRegionOverrideEnabled(region unique.Handle[inttypes.ServicePackageResourceRegion]) bool {
+	return !tfunique.IsHandleNil(region) && region.Value().IsOverrideEnabled
+}
+
+// frameworkWriteOnlyAttributes returns the sorted paths of all write-only attributes, including those in nested attributes and blocks.
+func frameworkWriteOnlyAttributes(prefix string, attributes map[string]schema.Attribute, blocks map[string]schema.Block) []string {
+	var paths []string
+
+	for name, attribute := range attributes {
+		path := prefix + name
+
+		if attribute.IsWriteOnly() {
+			paths = append(paths, path)
+		}
+
+		switch attribute := attribute.(type) {
+		case schema.ListNestedAttribute:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", attribute.NestedObject.Attributes, nil)...)
+		case schema.MapNestedAttribute:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", attribute.NestedObject.Attributes, nil)...)
+		case schema.SetNestedAttribute:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", attribute.NestedObject.Attributes, nil)...)
+		case schema.SingleNestedAttribute:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", attribute.Attributes, nil)...)
+		}
+	}
+
+	for name, block := range blocks {
+		path := prefix + name
+
+		switch block := block.(type) {
+		case schema.ListNestedBlock:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", block.NestedObject.Attributes, block.NestedObject.Blocks)...)
+		case schema.SetNestedBlock:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", block.NestedObject.Attributes, block.NestedObject.Blocks)...)
+		case schema.SingleNestedBlock:
+			paths = append(paths, frameworkWriteOnlyAttributes(path+".", block.Attributes, block.Blocks)...)
+		}
+	}
+
+	slices.Sort(paths)
+
+	return paths
+}
+
+// sdkWriteOnlyAttributes returns the sorted paths of all write-only attributes, including those in nested blocks.
+func sdkWriteOnlyAttributes(prefix string, schemaMap map[string]*sdkschema.Schema) []string {
+	var paths []string
+
+	for name, s := range schemaMap {
+		path := prefix + name
+
+		if s.WriteOnly {
+			paths = append(paths, path)
+		}
+
+		if v, ok := s.Elem.(*sdkschema.Resource); ok {
+			paths = append(paths, sdkWriteOnlyAttributes(path+".", v.SchemaMap())...)
+		}
+	}
+
+	slices.Sort(paths)
+
+	return paths
+}
+
+// isVCREnabled returns whether a service package's acceptance tests support go-vcr.
+// Enabling go-vcr (see docs/go-vcr.md) replaces uses of the shared acctest.Provider's meta with per-test meta.
+func isVCREnabled(dir string) (bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return false, err
+	}
+
+	var enabled bool
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return false, err
+		}
+
+		if bytes.Contains(b, []byte("acctest.Provider.Meta()")) {
+			return false, nil
+		}
+
+		if bytes.Contains(b, []byte("acctest.ProviderMeta(ctx, t)")) {
+			enabled = true
+		}
+	}
+
+	return enabled, nil
+}
+
+func writeJSON(w io.Writer, resources []resourceCapabilities) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(resources)
+}
+
+func writeCSV(w io.Writer, resources []resourceCapabilities) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{
+		"type_name",
+		"service_package",
+		"name",
+		"implementation",
+		"identity",
+		"import_by_identity",
+		"list",
+		"enhanced_region",
+		"tags",
+		"tag_policy_types",
+		"write_only_attributes",
+		"sweeper",
+		"vcr",
+	}); err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		if err := writer.Write([]string{
+			r.TypeName,
+			r.ServicePackage,
+			r.Name,
+			r.Implementation,
+			strconv.FormatBool(r.Identity),
+			strconv.FormatBool(r.ImportByIdentity),
+			strconv.FormatBool(r.List),
+			strconv.FormatBool(r.EnhancedRegion),
+			strconv.FormatBool(r.Tags),
+			strings.Join(r.TagPolicyTypes, ";"),
+			strings.Join(r.WriteOnlyAttributes, ";"),
+			strconv.FormatBool(r.Sweeper),
+			strconv.FormatBool(r.VCR),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
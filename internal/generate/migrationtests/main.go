@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/dlclark/regexp2" // Regexps include Perl syntax.
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/tests"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating Plugin SDK v2 to Plugin Framework migration tests for internal/service/%s", servicePackage)
+
+	var (
+		svc   serviceRecords
+		found bool
+	)
+
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		if p := l.SplitPackageRealPackage(); p != "" {
+			if p != servicePackage {
+				continue
+			}
+
+			ep := l.ProviderPackage()
+			if p == ep {
+				svc.primary = l
+				found = true
+			} else {
+				svc.additional = append(svc.additional, l)
+			}
+		} else {
+			p := l.ProviderPackage()
+
+			if p != servicePackage {
+				continue
+			}
+
+			svc.primary = l
+			found = true
+		}
+	}
+
+	if !found {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+
+	// Look for Terraform Plugin Framework resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.migratedResources {
+		resource.service = &svc
+
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		testDirPath := path.Join("testdata", resource.Name)
+
+		stateUpgrades, err := findStateUpgrades(path.Join(testDirPath, "sdkv2_state"))
+		if err != nil {
+			g.Fatalf("finding saved state for %q: %s", resource.TypeName, err)
+		}
+		resource.StateUpgrades = stateUpgrades
+
+		filename := fmt.Sprintf("%s_migration_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+
+		templates := template.New("migrationtests")
+
+		templates, err = tests.AddCommonResourceTestTemplates(templates)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+
+		templates, err = templates.Parse(resourceTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		basicConfigTmplFile := fmt.Sprintf("%s_basic.gtpl", sourceName)
+		basicConfigTmplPath := path.Join("testdata", "tmpl", basicConfigTmplFile)
+		var configTmplFile string
+		var configTmplPath string
+		if _, err := os.Stat(basicConfigTmplPath); err == nil {
+			configTmplFile = basicConfigTmplFile
+			configTmplPath = basicConfigTmplPath
+		} else if !errors.Is(err, os.ErrNotExist) {
+			g.Fatalf("accessing config template %q: %s", basicConfigTmplPath, err)
+		}
+
+		tagsConfigTmplFile := fmt.Sprintf("%s_tags.gtpl", sourceName)
+		tagsConfigTmplPath := path.Join("testdata", "tmpl", tagsConfigTmplFile)
+		if configTmplPath == "" {
+			if _, err := os.Stat(tagsConfigTmplPath); err == nil {
+				configTmplFile = tagsConfigTmplFile
+				configTmplPath = tagsConfigTmplPath
+			} else if !errors.Is(err, os.ErrNotExist) {
+				g.Fatalf("accessing config template %q: %s", tagsConfigTmplPath, err)
+			}
+		}
+
+		if configTmplPath == "" {
+			g.Fatalf("no config template found for %q at %q or %q", sourceName, basicConfigTmplPath, tagsConfigTmplPath)
+		}
+
+		b, err := os.ReadFile(configTmplPath)
+		if err != nil {
+			g.Fatalf("reading config template %q: %s", configTmplPath, err)
+		}
+		configTmpl := string(b)
+
+		additionalTfVars := tfmaps.Keys(resource.AdditionalTfVars_)
+		slices.Sort(additionalTfVars)
+
+		tfTemplates, err := template.New("migrationtests").Parse(testTfTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Terraform config template: %s", err)
+		}
+
+		tfTemplates, err = tests.AddCommonTfTemplates(tfTemplates)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+
+		// The configuration applied by the last Plugin SDK v2 release can be overridden, e.g. if it doesn't support an argument.
+		tfTemplatesSDK, err := tfTemplates.Clone()
+		if err != nil {
+			g.Fatalf("cloning Terraform config template: %s", err)
+		}
+
+		if _, err := tfTemplates.New("body").Parse(configTmpl); err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplPath, err)
+		}
+
+		configTmplSDKFile := fmt.Sprintf("%s_v%s%s", strings.TrimSuffix(configTmplFile, filepath.Ext(configTmplFile)), resource.PreFrameworkVersion, filepath.Ext(configTmplFile))
+		configTmplSDKPath := path.Join("testdata", "tmpl", configTmplSDKFile)
+		if b, err := os.ReadFile(configTmplSDKPath); err == nil {
+			configTmpl = string(b)
+			configTmplPath = configTmplSDKPath
+		} else if !errors.Is(err, os.ErrNotExist) {
+			g.Fatalf("reading config template %q: %s", configTmplSDKPath, err)
+		}
+
+		if _, err := tfTemplatesSDK.New("body").Parse(configTmpl); err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplPath, err)
+		}
+
+		commonConfig := commonConfig{
+			AdditionalTfVars: additionalTfVars,
+			RequiredEnvVars:  resource.RequiredEnvVars,
+			WithRName:        (resource.Generator != ""),
+		}
+
+		generateTestConfig(g, testDirPath, "migration", tfTemplates, commonConfig)
+
+		commonConfig.ExternalProviders = map[string]requiredProvider{
+			"aws": {
+				Source:  "hashicorp/aws",
+				Version: resource.PreFrameworkVersion.String(),
+			},
+		}
+
+		generateTestConfig(g, testDirPath, resource.PreFrameworkConfigDirectory(), tfTemplatesSDK, commonConfig)
+	}
+}
+
+type serviceRecords struct {
+	primary    data.ServiceRecord
+	additional []data.ServiceRecord
+}
+
+func (sr serviceRecords) ProviderPackage() string {
+	return sr.primary.ProviderPackage()
+}
+
+func (sr serviceRecords) ProviderNameUpper(typeName string) (string, error) {
+	if len(sr.additional) == 0 {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	for _, svc := range sr.additional {
+		if match, err := resourceTypeNameMatchesService(typeName, svc); err != nil {
+			return "", err
+		} else if match {
+			return svc.ProviderNameUpper(), nil
+		}
+	}
+
+	if match, err := resourceTypeNameMatchesService(typeName, sr.primary); err != nil {
+		return "", err
+	} else if match {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	return "", fmt.Errorf("No match found for resource type %q", typeName)
+}
+
+func resourceTypeNameMatchesService(typeName string, sr data.ServiceRecord) (bool, error) {
+	prefixActual := sr.ResourcePrefixActual()
+	if prefixActual != "" {
+		if match, err := resourceTypeNameMatchesPrefix(typeName, prefixActual); err != nil {
+			return false, err
+		} else if match {
+			return true, nil
+		}
+	}
+
+	if match, err := resourceTypeNameMatchesPrefix(typeName, sr.ResourcePrefixCorrect()); err != nil {
+		return false, err
+	} else if match {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func resourceTypeNameMatchesPrefix(typeName, typePrefix string) (bool, error) {
+	re, err := regexp2.Compile(typePrefix, 0)
+	if err != nil {
+		return false, err
+	}
+	match, err := re.MatchString(typeName)
+	if err != nil {
+		return false, err
+	}
+	return match, err
+}
+
+func (sr serviceRecords) PackageProviderNameUpper() string {
+	return sr.primary.ProviderNameUpper()
+}
+
+type ResourceDatum struct {
+	service             *serviceRecords
+	FileName            string
+	PreFrameworkVersion *version.Version // Last provider release in which the resource was implemented using Plugin SDK v2.
+	StateUpgrades       []stateUpgrade
+	tests.CommonArgs
+}
+
+func (d ResourceDatum) ProviderPackage() string {
+	return d.service.ProviderPackage()
+}
+
+func (d ResourceDatum) ResourceProviderNameUpper() (string, error) {
+	return d.service.ProviderNameUpper(d.TypeName)
+}
+
+func (d ResourceDatum) PackageProviderNameUpper() string {
+	return d.service.PackageProviderNameUpper()
+}
+
+func (d ResourceDatum) PreFrameworkConfigDirectory() string {
+	return fmt.Sprintf("migration_v%s", d.PreFrameworkVersion)
+}
+
+// stateUpgrade is a saved Plugin SDK v2 resource state and the state expected after it is upgraded by the current provider.
+type stateUpgrade struct {
+	Name         string
+	Version      int64 // Schema version of the saved state.
+	StateFile    string
+	ExpectedFile string
+}
+
+var (
+	stateFileRegexp = regexp.MustCompile(`^v([0-9]+)(_[0-9A-Za-z_]+)?\.json$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+// findStateUpgrades returns the saved states in dirPath.
+// Each saved state is named v<schema version>[_<description>].json and must be accompanied by a file named
+// v<schema version>[_<description>].expected.json containing the expected upgraded state.
+func findStateUpgrades(dirPath string) ([]stateUpgrade, error) {
+	entries, err := os.ReadDir(dirPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stateUpgrades []stateUpgrade
+	for _, entry := range entries {
+		m := stateFileRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || m == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema version in %q: %w", entry.Name(), err)
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".json")
+		expectedFile := path.Join(dirPath, name+".expected.json")
+		if _, err := os.Stat(expectedFile); err != nil {
+			return nil, fmt.Errorf("expected state for %q: %w", entry.Name(), err)
+		}
+
+		stateUpgrades = append(stateUpgrades, stateUpgrade{
+			Name:         name,
+			Version:      version,
+			StateFile:    path.Join(dirPath, entry.Name()),
+			ExpectedFile: expectedFile,
+		})
+	}
+
+	return stateUpgrades, nil
+}
+
+type commonConfig struct {
+	AdditionalTfVars  []string
+	WithRName         bool
+	ExternalProviders map[string]requiredProvider
+	RequiredEnvVars   []string
+}
+
+type requiredProvider struct {
+	Source  string
+	Version string
+}
+
+type ConfigDatum struct {
+	commonConfig
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	migratedResources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework resource migrated from Plugin SDK v2.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := ResourceDatum{
+		FileName:   v.fileName,
+		CommonArgs: tests.InitCommonArgs(),
+	}
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName, args := m[1], common.ParseArgs(m[3]); annotationName {
+			case "FrameworkResource":
+				d.Implementation = common.ImplementationFramework
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				d.TypeName = args.Positional[0]
+
+				if attr, ok := args.Keyword["name"]; ok {
+					attr = strings.ReplaceAll(attr, " ", "")
+					d.Name = strings.ReplaceAll(attr, "-", "")
+				}
+
+			case "Testing":
+				if err := tests.ParseTestingAnnotations(args, &d.CommonArgs); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("%s: %w", fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+
+				if attr, ok := args.Keyword["preFrameworkVersion"]; ok {
+					version, err := version.NewVersion(attr)
+					if err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid preFrameworkVersion value: %q at %s. Should be version value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					}
+					d.PreFrameworkVersion = version
+				}
+			}
+		}
+	}
+
+	if d.Implementation == common.ImplementationFramework && d.PreFrameworkVersion != nil {
+		if err := tests.Configure(&d.CommonArgs); err != nil {
+			v.errs = append(v.errs, fmt.Errorf("%s: %w", fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+			return
+		}
+
+		v.migratedResources = append(v.migratedResources, d)
+	}
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+func generateTestConfig(g *common.Generator, dirPath, test string, tfTemplates *template.Template, config commonConfig) {
+	dirPath = path.Join(dirPath, test)
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	configData := ConfigDatum{
+		commonConfig: config,
+	}
+	if err := tf.BufferTemplateSet(tfTemplates, configData); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
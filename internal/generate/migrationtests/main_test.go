@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// Run `go test -tags generate ./internal/generate/migrationtests -update` to rewrite the golden files.
+var update = flag.Bool("update", false, "update golden files")
+
+// TestGenerate runs the generator over the service package in testdata/fixture and compares
+// the generated files with those in testdata/golden.
+func TestGenerate(t *testing.T) { //nolint:paralleltest // Changes the working directory.
+	goldenDir, err := filepath.Abs(filepath.Join("testdata", "golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := os.CopyFS(dir, os.DirFS(filepath.Join("testdata", "fixture"))); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Chdir(dir)
+	t.Setenv("GOPACKAGE", "logs")
+
+	main()
+
+	generated := []string{
+		"example_migration_gen_test.go",
+		"testdata/Example/migration/main_gen.tf",
+		"testdata/Example/migration_v6.0.0/main_gen.tf",
+	}
+
+	var got []string
+	err = filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && (filepath.Base(path) == "main_gen.tf" || filepath.Ext(path) == ".go" && path != "example.go") {
+			got = append(got, filepath.ToSlash(path))
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slices.Sort(got)
+	if !slices.Equal(got, generated) {
+		t.Fatalf("generated files = %v, want %v", got, generated)
+	}
+
+	for _, name := range generated {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		goldenFile := filepath.Join(goldenDir, filepath.FromSlash(name))
+
+		if *update {
+			if err := os.MkdirAll(filepath.Dir(goldenFile), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(goldenFile, b, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+		}
+
+		want, err := os.ReadFile(goldenFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got, want := string(b), string(want); got != want {
+			t.Errorf("%s:\ngot:\n%s\nwant:\n%s", name, got, want)
+		}
+	}
+}
+
+func TestFindStateUpgrades(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		files         []string
+		expected      []stateUpgrade
+		expectedError bool
+	}{
+		"no saved state": {},
+		"saved states": {
+			files: []string{"v0.json", "v0.expected.json", "v1_with_tags.json", "v1_with_tags.expected.json"},
+			expected: []stateUpgrade{
+				{Name: "v0", Version: 0, StateFile: "v0.json", ExpectedFile: "v0.expected.json"},
+				{Name: "v1_with_tags", Version: 1, StateFile: "v1_with_tags.json", ExpectedFile: "v1_with_tags.expected.json"},
+			},
+		},
+		"other files ignored": {
+			files: []string{"README.md", "state.json", "v0.json", "v0.expected.json"},
+			expected: []stateUpgrade{
+				{Name: "v0", Version: 0, StateFile: "v0.json", ExpectedFile: "v0.expected.json"},
+			},
+		},
+		"no expected state": {
+			files:         []string{"v0.json"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dirPath := filepath.Join(t.TempDir(), "sdkv2_state")
+			if testCase.files != nil {
+				if err := os.Mkdir(dirPath, 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, file := range testCase.files {
+				if err := os.WriteFile(filepath.Join(dirPath, file), []byte("{}"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := findStateUpgrades(dirPath)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for i := range testCase.expected {
+				testCase.expected[i].StateFile = filepath.Join(dirPath, testCase.expected[i].StateFile)
+				testCase.expected[i].ExpectedFile = filepath.Join(dirPath, testCase.expected[i].ExpectedFile)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("findStateUpgrades() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
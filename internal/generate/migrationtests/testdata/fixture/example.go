@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+// @FrameworkResource("aws_cloudwatch_log_example", name="Example")
+// @Testing(preFrameworkVersion="6.0.0")
+func newExampleResource() {}
+
+// Resources that were not migrated from Plugin SDK v2 are skipped.
+// @FrameworkResource("aws_cloudwatch_log_other", name="Other")
+func newOtherResource() {}
@@ -223,6 +223,9 @@ type ResourceDatum struct {
 	ImportIDHandler                   string
 	SetIDAttribute                    bool
 	HasIdentityFix                    bool
+	PermissionsCreate                 []string
+	PermissionsUpdate                 []string
+	PermissionsDelete                 []string
 	common.ResourceIdentity
 }
 
@@ -238,6 +241,10 @@ func (d ResourceDatum) RegionOverrideEnabled() bool {
 	return d.regionOverrideEnabled && !d.IsGlobal
 }
 
+func (r ResourceDatum) HasPermissions() bool {
+	return len(r.PermissionsCreate) > 0 || len(r.PermissionsUpdate) > 0 || len(r.PermissionsDelete) > 0
+}
+
 func (r ResourceDatum) WrappedImport() bool {
 	return r.wrappedImport == common.TriBooleanTrue
 }
@@ -268,8 +275,9 @@ var endpointResolverTmpl string
 
 // Annotation processing.
 var (
-	annotation    = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
-	validTypeName = regexache.MustCompile(`^aws(?:_[a-z0-9]+)+$`)
+	annotation     = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+	validTypeName  = regexache.MustCompile(`^aws(?:_[a-z0-9]+)+$`)
+	validIAMAction = regexache.MustCompile(`^[0-9a-z-]+:[0-9A-Za-z*]+$`)
 )
 
 type visitor struct {
@@ -462,6 +470,31 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "IdentityFix":
 				d.HasIdentityFix = true
 
+			case "Permissions":
+				// IAM actions are separated by semicolons, e.g. @Permissions(create="sqs:CreateQueue;sqs:TagQueue").
+				for _, op := range []struct {
+					keyword string
+					actions *[]string
+				}{
+					{"create", &d.PermissionsCreate},
+					{"update", &d.PermissionsUpdate},
+					{"delete", &d.PermissionsDelete},
+				} {
+					attr, ok := args.Keyword[op.keyword]
+					if !ok {
+						continue
+					}
+
+					for action := range strings.SplitSeq(attr, ";") {
+						action = strings.TrimSpace(action)
+						if !validIAMAction.MatchString(action) {
+							v.errs = append(v.errs, fmt.Errorf("invalid Permissions/%s value (%s): %s", op.keyword, action, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+							continue
+						}
+						*op.actions = append(*op.actions, action)
+					}
+				}
+
 			default:
 				if err := common.ParseResourceIdentity(annotationName, args, implementation, &d.ResourceIdentity, &d.goImports); err != nil {
 					v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
@@ -697,7 +730,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity", "Permissions":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
 				// Ignored.
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// WalkFiles calls fn for each regular file under the root directory, in lexical order.
+// Symbolic links to regular files are followed.
+// name is the file's slash-separated path relative to root and filename is the file's local path.
+// Only files that match an include pattern (or all files if there are none) and no exclude pattern are visited.
+func WalkFiles(root string, include, exclude []string, fn func(name, filename string, fi fs.FileInfo) error) error {
+	root, err := homedir.Expand(root)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(root, func(filename string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		fi, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		name, err := filepath.Rel(root, filename)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+
+		if !FileIncluded(name, include, exclude) {
+			return nil
+		}
+
+		return fn(name, filename, fi)
+	})
+}
+
+// FileIncluded returns whether the slash-separated path matches any include pattern
+// (or there are none) and no exclude pattern.
+func FileIncluded(name string, include, exclude []string) bool {
+	if len(include) > 0 && !slices.ContainsFunc(include, func(pattern string) bool { return GlobMatch(pattern, name) }) {
+		return false
+	}
+
+	return !slices.ContainsFunc(exclude, func(pattern string) bool { return GlobMatch(pattern, name) })
+}
+
+// GlobMatch reports whether the slash-separated name matches the pattern.
+// Each path segment is matched as by filepath.Match, and a "**" segment matches zero or more segments,
+// as in Terraform's fileset function.
+func GlobMatch(pattern, name string) bool {
+	return globMatchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func globMatchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if globMatchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, err := filepath.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
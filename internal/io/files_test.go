@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+)
+
+func TestGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/api/index.html", true},
+		{"docs/**", "docs/api/index.html", true},
+		{"docs/**", "img/logo.png", false},
+		{"docs/*/index.html", "docs/api/index.html", true},
+		{"docs/*/index.html", "docs/index.html", false},
+		{"img/logo.???", "img/logo.png", true},
+		{"[", "[", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+" "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfio.GlobMatch(testCase.pattern, testCase.name), testCase.expected; got != want {
+				t.Errorf("GlobMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, want)
+			}
+		})
+	}
+}
+
+func TestFileIncluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		include  []string
+		exclude  []string
+		expected bool
+	}{
+		"no patterns": {
+			name:     "index.html",
+			expected: true,
+		},
+		"included": {
+			name:     "docs/index.html",
+			include:  []string{"*.css", "**/*.html"},
+			expected: true,
+		},
+		"not included": {
+			name:     "notes.txt",
+			include:  []string{"**/*.html"},
+			expected: false,
+		},
+		"excluded": {
+			name:     ".git/config",
+			exclude:  []string{".git/**"},
+			expected: false,
+		},
+		"included and excluded": {
+			name:     "docs/index.html",
+			include:  []string{"**/*.html"},
+			exclude:  []string{"docs/**"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfio.FileIncluded(testCase.name, testCase.include, testCase.exclude), testCase.expected; got != want {
+				t.Errorf("FileIncluded(%q) = %t, want %t", testCase.name, got, want)
+			}
+		})
+	}
+}
+
+func TestWalkFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"index.html", "css/site.css", "docs/api/index.html", ".git/config"} {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	err := tfio.WalkFiles(dir, []string{"**/*.html", "**/*.css"}, []string{"docs/**"}, func(name, filename string, _ fs.FileInfo) error {
+		if want := filepath.Join(dir, filepath.FromSlash(name)); filename != want {
+			t.Errorf("WalkFiles(%q) filename = %q, want %q", name, filename, want)
+		}
+
+		got = append(got, name)
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"css/site.css", "index.html"}; !slices.Equal(got, want) {
+		t.Errorf("WalkFiles = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package permissionsim evaluates, using the IAM policy simulator, whether the
+// provider's caller identity is allowed to perform the IAM actions that planned
+// resource changes require.
+package permissionsim
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const (
+	// SeverityEnvVar is the environment variable that configures permission simulation severity.
+	SeverityEnvVar = "TF_AWS_PERMISSION_SIMULATION"
+)
+
+// Config contains options related to plan-time IAM permission simulation.
+type Config struct {
+	// Severity is either "error" or "warning".
+	Severity string
+	// PrincipalARN is the ARN of the IAM user or role whose policies are simulated.
+	PrincipalARN string
+
+	client  *iam.Client
+	results sync.Map // Cache key -> denied actions.
+}
+
+// NewConfig resolves the caller identity for the specified AWS configuration and returns a permission simulation configuration.
+func NewConfig(ctx context.Context, awsConfig aws.Config, severity string) (*Config, error) {
+	client := iam.NewFromConfig(awsConfig)
+
+	output, err := sts.NewFromConfig(awsConfig).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("reading STS Caller Identity: %w", err)
+	}
+
+	principalARN := aws.ToString(output.Arn)
+	if roleName, ok := roleNameFromAssumedRoleARN(principalARN); ok {
+		// The simulator requires the IAM role ARN, including any path.
+		output, err := client.GetRole(ctx, &iam.GetRoleInput{
+			RoleName: aws.String(roleName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading IAM Role (%s): %w", roleName, err)
+		}
+
+		principalARN = aws.ToString(output.Role.Arn)
+	} else if !isSimulatablePrincipalARN(principalARN) {
+		return nil, fmt.Errorf("caller identity (%s) is not an IAM user or role", principalARN)
+	}
+
+	return &Config{
+		Severity:     severity,
+		PrincipalARN: principalARN,
+		client:       client,
+	}, nil
+}
+
+// DeniedActions returns the sorted subset of the specified IAM actions that the principal is not allowed to perform.
+// Actions are simulated against all resources. Results are cached for the lifetime of the provider.
+func (c *Config) DeniedActions(ctx context.Context, actions []string) ([]string, error) {
+	actions = slices.Sorted(slices.Values(actions))
+	actions = slices.Compact(actions)
+	key := strings.Join(actions, ",")
+
+	if v, ok := c.results.Load(key); ok {
+		return v.([]string), nil
+	}
+
+	input := iam.SimulatePrincipalPolicyInput{
+		ActionNames:     actions,
+		PolicySourceArn: aws.String(c.PrincipalARN),
+	}
+	var denied []string
+	pages := iam.NewSimulatePrincipalPolicyPaginator(c.client, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("simulating IAM Principal (%s) policy: %w", c.PrincipalARN, err)
+		}
+
+		for _, v := range page.EvaluationResults {
+			if v.EvalDecision != awstypes.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.ToString(v.EvalActionName))
+			}
+		}
+	}
+	slices.Sort(denied)
+
+	c.results.Store(key, denied)
+
+	return denied, nil
+}
+
+// roleNameFromAssumedRoleARN returns the IAM role name from an STS assumed role session ARN,
+// e.g. "arn:aws:sts::123456789012:assumed-role/example/session".
+func roleNameFromAssumedRoleARN(s string) (string, bool) {
+	v, err := arn.Parse(s)
+	if err != nil || v.Service != "sts" {
+		return "", false
+	}
+
+	parts := strings.Split(v.Resource, "/")
+	if len(parts) != 3 || parts[0] != "assumed-role" {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// isSimulatablePrincipalARN returns whether the specified ARN is an IAM user or role ARN.
+func isSimulatablePrincipalARN(s string) bool {
+	v, err := arn.Parse(s)
+	if err != nil || v.Service != "iam" {
+		return false
+	}
+
+	return strings.HasPrefix(v.Resource, "user/") || strings.HasPrefix(v.Resource, "role/")
+}
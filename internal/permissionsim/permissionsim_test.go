@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package permissionsim
+
+import (
+	"testing"
+)
+
+func TestRoleNameFromAssumedRoleARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input        string
+		expectedName string
+		expectedOK   bool
+	}{
+		"assumed role": {
+			input:        "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+			expectedName: "example",
+			expectedOK:   true,
+		},
+		"IAM user": {
+			input: "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+		},
+		"federated user": {
+			input: "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT005
+		},
+		"invalid ARN": {
+			input: "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotName, gotOK := roleNameFromAssumedRoleARN(testCase.input)
+
+			if got, want := gotOK, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := gotName, testCase.expectedName; got != want {
+				t.Errorf("name = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIsSimulatablePrincipalARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected bool
+	}{
+		"IAM user": {
+			input:    "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+			expected: true,
+		},
+		"IAM role with path": {
+			input:    "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT005
+			expected: true,
+		},
+		"root": {
+			input: "arn:aws:iam::123456789012:root", //lintignore:AWSAT005
+		},
+		"federated user": {
+			input: "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isSimulatablePrincipalARN(testCase.input), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func validateInContextAccount(ctx context.Context, c awsClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.ValidateInContextAccount(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrProviderAccount), "Invalid Provider Account Value", err.Error())
+	}
+
+	return diags
+}
+
+type dataSourceInjectProviderAccountAttributeInterceptor struct{}
+
+func (r dataSourceInjectProviderAccountAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[datasource.SchemaRequest, datasource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrProviderAccount]; !ok {
+			// Inject a top-level "provider_account" attribute.
+			response.Schema.Attributes[names.AttrProviderAccount] = dsschema.StringAttribute{
+				Optional:    true,
+				Description: names.ResourceTopLevelProviderAccountAttributeDescription,
+			}
+		}
+	}
+}
+
+// dataSourceInjectProviderAccountAttribute injects a top-level "provider_account" attribute into a data source's schema.
+func dataSourceInjectProviderAccountAttribute() dataSourceSchemaInterceptor {
+	return &dataSourceInjectProviderAccountAttributeInterceptor{}
+}
+
+type dataSourceValidateAccountInterceptor struct{}
+
+func (r dataSourceValidateAccountInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		// As data sources have no ModifyPlan functionality we validate the per-resource account override value before R.
+		opts.response.Diagnostics.Append(validateInContextAccount(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// dataSourceValidateAccount validates that the value of the top-level `provider_account` attribute names a configured account.
+func dataSourceValidateAccount() dataSourceCRUDInterceptor {
+	return &dataSourceValidateAccountInterceptor{}
+}
+
+type resourceInjectProviderAccountAttributeInterceptor struct{}
+
+func (r resourceInjectProviderAccountAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrProviderAccount]; !ok {
+			// Inject a top-level "provider_account" attribute.
+			response.Schema.Attributes[names.AttrProviderAccount] = resourceattribute.ProviderAccount()
+		}
+	}
+}
+
+// resourceInjectProviderAccountAttribute injects a top-level "provider_account" attribute into a resource's schema.
+func resourceInjectProviderAccountAttribute() resourceSchemaInterceptor {
+	return &resourceInjectProviderAccountAttributeInterceptor{}
+}
+
+type resourceValidateAccountInterceptor struct{}
+
+func (r resourceValidateAccountInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		opts.response.Diagnostics.Append(validateInContextAccount(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// resourceValidateAccount validates that the value of the top-level `provider_account` attribute names a configured account.
+func resourceValidateAccount() resourceModifyPlanInterceptor {
+	return &resourceValidateAccountInterceptor{}
+}
+
+type resourceImportAccountInterceptor struct{}
+
+func (r resourceImportAccountInterceptor) importState(ctx context.Context, opts interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// Import ID optionally ends with "@<account>", where <account> is a named account in the provider configuration.
+		// Any "@<region>" suffix precedes it.
+		if i := strings.LastIndex(request.ID, "@"); i > 0 {
+			if id, account := request.ID[:i], request.ID[i+1:]; c.IsAccountConfigured(account) {
+				request.ID = id
+				opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrProviderAccount), account)...)
+				if opts.response.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// resourceImportAccount sets the value of the top-level `provider_account` attribute during import.
+func resourceImportAccount() resourceImportStateInterceptor {
+	return &resourceImportAccountInterceptor{}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -394,10 +395,18 @@ func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) IsAccountConfigured(name string) bool {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) PermissionSimulationConfig(context.Context) *permissionsim.Config {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
@@ -406,6 +415,10 @@ func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ValidateInContextAccount(ctx context.Context) error {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -25,9 +26,12 @@ type awsClient interface {
 	Region(context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
+	IsAccountConfigured(name string) bool
 	Partition(context.Context) string
+	PermissionSimulationConfig(context.Context) *permissionsim.Config
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
+	ValidateInContextAccount(ctx context.Context) error
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
 }
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// resourceValidatePermissions simulates the IAM actions required by a planned create, update or delete.
+func resourceValidatePermissions(permissions inttypes.ServicePackageResourcePermissions) resourceModifyPlanInterceptor {
+	return &resourceValidatePermissionsInterceptor{
+		permissions: permissions,
+	}
+}
+
+type resourceValidatePermissionsInterceptor struct {
+	permissions inttypes.ServicePackageResourcePermissions
+}
+
+func (r resourceValidatePermissionsInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	config := c.PermissionSimulationConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+	if !ok {
+		return
+	}
+
+	switch request, when := opts.request, opts.when; when {
+	case Before:
+		var operation string
+		var actions []string
+
+		switch {
+		case request.State.Raw.IsNull():
+			operation, actions = "create", r.permissions.Create
+		case request.Plan.Raw.IsNull():
+			// If the entire plan is null, the resource is planned for destruction.
+			operation, actions = "delete", r.permissions.Delete
+		case !request.Plan.Raw.Equal(request.State.Raw):
+			operation, actions = "update", r.permissions.Update
+		}
+
+		if len(actions) == 0 {
+			return
+		}
+
+		denied, err := config.DeniedActions(ctx, actions)
+		if err != nil {
+			// Failure to simulate never blocks a plan.
+			opts.response.Diagnostics.AddWarning("IAM Permission Simulation Failed", err.Error())
+			return
+		}
+
+		if len(denied) == 0 {
+			return
+		}
+
+		summary := "Missing IAM Permissions"
+		detail := fmt.Sprintf("IAM policy simulation indicates that %s is not allowed to perform the following actions required to %s %s: %s", config.PrincipalARN, operation, typeName, denied)
+
+		switch config.Severity {
+		case "warning":
+			opts.response.Diagnostics.AddWarning(summary, detail)
+		default:
+			opts.response.Diagnostics.AddError(summary, detail)
+		}
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
@@ -107,6 +108,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON object is appended for each AWS API call. Can also be configured using the `TF_AWS_AUDIT_LOG_FILE` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -143,10 +148,22 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"permission_simulation": schema.StringAttribute{
+				Optional: true,
+				Description: `The severity with which to report missing IAM permissions found by simulating, during plan, the IAM actions required to create, update or delete resources. ` +
+					`Valid values are "error", "warning", and "disabled". ` +
+					`When unset or "disabled", permissions are not simulated. ` +
+					`Can also be configured with the ` + permissionsim.SeverityEnvVar + ` environment variable.`,
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_cache_ttl": schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "Time-to-live of the results of read (`Describe*`, `Get*` and `List*`) AWS API calls that are cached while reading resources and data sources. Valid time units are ns, us (or µs), ms, s, h, or m. If not set, results are not cached. Can also be configured using the `TF_AWS_READ_CACHE_TTL` environment variable.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -225,52 +242,25 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
+			"account": schema.ListNestedBlock{
+				Description: "Configuration blocks with named AWS accounts that resources can select via the top-level `provider_account` argument.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
-						"duration": schema.StringAttribute{
-							CustomType:  fwtypes.DurationType,
-							Optional:    true,
-							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						names.AttrName: schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the account.",
 						},
-						"external_id": schema.StringAttribute{
+						names.AttrRegion: schema.StringAttribute{
 							Optional:    true,
-							Description: "A unique identifier that might be required when you assume a role in another account.",
-						},
-						"policy": schema.StringAttribute{
-							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"policy_arns": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"role_arn": schema.StringAttribute{
-							Optional:    true, // For historical reasons, we allow an empty `assume_role` block
-							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
-						},
-						"session_name": schema.StringAttribute{
-							Optional:    true,
-							Description: "An identifier for the assumed role session.",
-						},
-						"source_identity": schema.StringAttribute{
-							Optional:    true,
-							Description: "Source identity specified by the principal assuming the role.",
-						},
-						"tags": schema.MapAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tags.",
-						},
-						"transitive_tag_keys": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tag keys to pass to any subsequent sessions.",
+							Description: "The region where AWS operations for the account will take place. Defaults to the provider's region.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"assume_role": assumeRoleBlock(),
+					},
 				},
 			},
+			"assume_role": assumeRoleBlock(),
 			"assume_role_with_web_identity": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -347,6 +337,35 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"service_retry": schema.ListNestedBlock{
+				Description: "Configuration blocks with per-service settings that override the provider's API retry and rate limiting configuration.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request to the service is attempted. Overrides `max_retries`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The maximum delay between retries of an AWS API request to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"requests_per_second": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of AWS API requests per second sent to the service, including retries.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional AWS API error codes for which requests to the service are retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service package name, e.g. `ec2` or `route53`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -574,11 +593,63 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+func assumeRoleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"duration": schema.StringAttribute{
+					CustomType:  fwtypes.DurationType,
+					Optional:    true,
+					Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"external_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "A unique identifier that might be required when you assume a role in another account.",
+				},
+				"policy": schema.StringAttribute{
+					Optional:    true,
+					Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"role_arn": schema.StringAttribute{
+					Optional:    true, // For historical reasons, we allow an empty `assume_role` block
+					Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+				},
+				"source_identity": schema.StringAttribute{
+					Optional:    true,
+					Description: "Source identity specified by the principal assuming the role.",
+				},
+				"tags": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tags.",
+				},
+				"transitive_tag_keys": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tag keys to pass to any subsequent sessions.",
+				},
+			},
+		},
+	}
+}
+
 func validateSchemaRegionForDataSource(regionSpec unique.Handle[inttypes.ServicePackageResourceRegion], schema datasourceschema.Schema) error {
 	if !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		if _, ok := schema.Attributes[names.AttrRegion]; ok {
 			return fmt.Errorf("configured for enhanced regions but defines `%s` attribute in schema", names.AttrRegion)
 		}
+		if _, ok := schema.Attributes[names.AttrProviderAccount]; ok {
+			return fmt.Errorf("configured for enhanced regions but defines `%s` attribute in schema", names.AttrProviderAccount)
+		}
 	}
 	return nil
 }
@@ -608,6 +679,9 @@ func validateSchemaRegionForResource(regionSpec unique.Handle[inttypes.ServicePa
 		if _, ok := schema.Attributes[names.AttrRegion]; ok {
 			return fmt.Errorf("configured for enhanced regions but defines `%s` attribute in schema", names.AttrRegion)
 		}
+		if _, ok := schema.Attributes[names.AttrProviderAccount]; ok {
+			return fmt.Errorf("configured for enhanced regions but defines `%s` attribute in schema", names.AttrProviderAccount)
+		}
 	}
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -335,7 +336,17 @@ func (r resourceImportRegionInterceptor) importState(ctx context.Context, opts i
 				return
 			}
 		} else {
-			opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), c.AwsConfig(ctx).Region)...)
+			// Default to the Region of any named account set from the import ID.
+			var account types.String
+			opts.response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrProviderAccount), &account)...)
+			if opts.response.Diagnostics.HasError() {
+				return
+			}
+			if v := account.ValueString(); v != "" {
+				ctx = conns.WithOverrideAccount(ctx, v)
+			}
+
+			opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
 			if opts.response.Diagnostics.HasError() {
 				return
 			}
@@ -7,6 +7,8 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -17,3 +19,13 @@ var Region = sync.OnceValue(func() schema.Attribute {
 		Description: names.ResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var ProviderAccount = sync.OnceValue(func() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: names.ResourceTopLevelProviderAccountAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+})
@@ -57,6 +57,8 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 		if v.IsValidateOverrideInPartition {
 			interceptors = append(interceptors, dataSourceValidateRegion())
 		}
+		interceptors = append(interceptors, dataSourceInjectProviderAccountAttribute())
+		interceptors = append(interceptors, dataSourceValidateAccount())
 		interceptors = append(interceptors, dataSourceSetRegionInState())
 	}
 
@@ -77,7 +79,7 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAccount, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -92,9 +94,19 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 		}
 
 		overrideRegion = target.ValueString()
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrProviderAccount), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAccount = target.ValueString()
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	if overrideAccount != "" {
+		ctx = conns.WithOverrideAccount(ctx, overrideAccount)
+	}
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -140,6 +152,7 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	ctx = conns.WithReadCache(ctx)
 	interceptedHandler(w.interceptors.dataSourceRead(), w.inner.Read, dataSourceReadHasError, w.meta)(ctx, request, response)
 }
 
@@ -534,9 +547,13 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		if v.IsValidateOverrideInPartition {
 			interceptors = append(interceptors, resourceValidateRegion())
 		}
+		interceptors = append(interceptors, resourceInjectProviderAccountAttribute())
+		interceptors = append(interceptors, resourceValidateAccount())
 		interceptors = append(interceptors, resourceDefaultRegion())
 		interceptors = append(interceptors, resourceForceNewIfRegionChanges())
 		interceptors = append(interceptors, resourceSetRegionInState())
+		// The account is removed from the end of the import ID before the Region.
+		interceptors = append(interceptors, resourceImportAccount())
 		if spec.Identity.HasInherentRegion() {
 			interceptors = append(interceptors, resourceImportRegionNoDefault())
 		} else {
@@ -549,6 +566,10 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	if v := spec.Permissions; len(v.Create) > 0 || len(v.Update) > 0 || len(v.Delete) > 0 {
+		interceptors = append(interceptors, resourceValidatePermissions(v))
+	}
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -586,7 +607,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedResource) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAccount, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -601,9 +622,19 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 		}
 
 		overrideRegion = target.ValueString()
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrProviderAccount), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAccount = target.ValueString()
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	if overrideAccount != "" {
+		ctx = conns.WithOverrideAccount(ctx, overrideAccount)
+	}
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -660,6 +691,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
+	ctx = conns.WithReadCache(ctx)
 	interceptedHandler(w.interceptors.resourceRead(), w.inner.Read, resourceReadHasError, w.meta)(ctx, request, response)
 }
 
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceValidateAccount() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				return c.ValidateInContextAccount(ctx)
+			}
+		}
+
+		return nil
+	})
+}
+
+func dataSourceValidateAccount() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Read:
+				// As data sources have no CustomizeDiff functionality, we validate the per-resource account override value here.
+				if err := c.ValidateInContextAccount(ctx); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+func importAccount() importInterceptor {
+	return interceptorFunc1[*schema.ResourceData, error](func(ctx context.Context, opts importInterceptorOptions) error {
+		c, d := opts.c, opts.d
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Import:
+				// Import ID optionally ends with "@<account>", where <account> is a named account in the provider configuration.
+				// Any "@<region>" suffix precedes it.
+				if i := strings.LastIndex(d.Id(), "@"); i > 0 {
+					if id, account := d.Id()[:i], d.Id()[i+1:]; c.IsAccountConfigured(account) {
+						d.SetId(id)
+						d.Set(names.AttrProviderAccount, account)
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// resourceImportAccount sets the value of the top-level `provider_account` attribute during import.
+func resourceImportAccount() interceptorInvocation {
+	return interceptorInvocation{
+		when:        Before,
+		why:         Import,
+		interceptor: importAccount(),
+	}
+}
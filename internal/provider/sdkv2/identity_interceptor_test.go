@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -311,14 +312,26 @@ func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) IsAccountConfigured(name string) bool {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) PermissionSimulationConfig(context.Context) *permissionsim.Config {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ValidateInContextAccount(ctx context.Context) error {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
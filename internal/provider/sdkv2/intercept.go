@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -23,9 +24,12 @@ type awsClient interface {
 	Region(ctx context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
+	IsAccountConfigured(name string) bool
 	Partition(context.Context) string
+	PermissionSimulationConfig(context.Context) *permissionsim.Config
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(context.Context) *tftags.TagPolicyConfig
+	ValidateInContextAccount(ctx context.Context) error
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
 }
@@ -145,6 +149,10 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
+		if why == Read {
+			ctx = conns.WithReadCache(ctx)
+		}
+
 		var interceptors []crudInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
 			if interceptor, ok := v.interceptor.(crudInterceptor); ok {
@@ -279,8 +287,8 @@ func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocatio
 		return nil
 	}
 
-	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx, err := bootstrapContext(ctx, d.GetOk, meta)
+	return func(parentCtx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		ctx, err := bootstrapContext(parentCtx, d.GetOk, meta)
 		if err != nil {
 			return nil, err
 		}
@@ -315,6 +323,12 @@ func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocatio
 			}
 		}
 
+		// Before interceptors may set per-resource overrides from the import ID.
+		ctx, err = bootstrapContext(parentCtx, d.GetOk, meta)
+		if err != nil {
+			return nil, err
+		}
+
 		var errs []error
 
 		r, err := f(ctx, d, meta)
@@ -18,3 +18,20 @@ var Region = sync.OnceValue(func() *schema.Schema {
 		Description: names.ResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var ProviderAccount = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: names.ResourceTopLevelProviderAccountAttributeDescription,
+	}
+})
+
+var DataSourceProviderAccount = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: names.ResourceTopLevelProviderAccountAttributeDescription,
+	}
+})
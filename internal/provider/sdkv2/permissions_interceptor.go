@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// validatePermissions simulates the IAM actions required by a planned create or update.
+// Planned deletes are not simulated as CustomizeDiff is not called when a resource is destroyed.
+func validatePermissions(permissions inttypes.ServicePackageResourcePermissions) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		config := c.PermissionSimulationConfig(ctx)
+		if config == nil {
+			return nil
+		}
+
+		_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+		if !ok {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				var operation string
+				var actions []string
+
+				switch {
+				case d.GetRawState().IsNull():
+					operation, actions = "create", permissions.Create
+				case len(d.GetChangedKeysPrefix("")) > 0:
+					operation, actions = "update", permissions.Update
+				}
+
+				if len(actions) == 0 {
+					return nil
+				}
+
+				denied, err := config.DeniedActions(ctx, actions)
+				if err != nil {
+					// Failure to simulate never blocks a plan.
+					tflog.Warn(ctx, "IAM Permission Simulation", map[string]any{
+						"error": err.Error(),
+					})
+					return nil
+				}
+
+				if len(denied) == 0 {
+					return nil
+				}
+
+				summary := "Missing IAM Permissions"
+				detail := fmt.Sprintf("IAM policy simulation indicates that %s is not allowed to perform the following actions required to %s %s: %s", config.PrincipalARN, operation, typeName, denied)
+
+				// CustomizeDiff does not support diagnostics (only an error return)
+				switch config.Severity {
+				case "warning":
+					// Warning diagnostics are only logged
+					tflog.Warn(ctx, "IAM Permission Simulation", map[string]any{
+						"summary": summary,
+						"detail":  detail,
+					})
+				default:
+					// Error diagnostics merge summary and detail into a single message
+					return fmt.Errorf("%s - %s", summary, detail)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -61,6 +62,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The access key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"account": accountSchema(),
 				"allowed_account_ids": {
 					Type:          schema.TypeSet,
 					Elem:          &schema.Schema{Type: schema.TypeString},
@@ -69,6 +71,11 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"audit_log_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path of a file to which a JSON object is appended for each AWS API call. Can also be configured using the `TF_AWS_AUDIT_LOG_FILE` environment variable.",
+				},
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -167,12 +174,26 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"permission_simulation": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The severity with which to report missing IAM permissions found by simulating, during plan, the IAM actions required to create, update or delete resources. ` +
+						`Valid values are "error", "warning", and "disabled". ` +
+						`When unset or "disabled", permissions are not simulated. ` +
+						`Can also be configured with the ` + permissionsim.SeverityEnvVar + ` environment variable.`,
+				},
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"read_cache_ttl": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Time-to-live of the results of read (`Describe*`, `Get*` and `List*`) AWS API calls that are cached while reading resources and data sources. Valid time units are ns, us (or µs), ms, s, h, or m. If not set, results are not cached. Can also be configured using the `TF_AWS_READ_CACHE_TTL` environment variable.",
+					ValidateFunc: verify.ValidDuration,
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -206,6 +227,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_retry": serviceRetrySchema(),
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -344,6 +366,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogFile:                   d.Get("audit_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -365,6 +388,17 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if config.AuditLogFile == "" {
+		config.AuditLogFile = os.Getenv(conns.AuditLogFileEnvVar)
+	}
+
+	readCacheTTL, dg := expandReadCacheTTL(d.Get("read_cache_ttl").(string))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.ReadCacheTTL = readCacheTTL
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -390,6 +424,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.S3USEast1RegionalEndpoint = endpoint
 	}
 
+	if v, ok := d.GetOk("account"); ok && len(v.([]any)) > 0 {
+		accounts, dg := expandAccounts(ctx, cty.GetAttrPath("account"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.Accounts = accounts
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -481,10 +524,26 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	permissionSimulation, dg := expandPermissionSimulation(cty.GetAttrPath("permission_simulation"), d.Get("permission_simulation").(string))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.PermissionSimulation = permissionSimulation
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_retry"); ok && len(v.([]any)) > 0 {
+		serviceRetry, dg := expandServiceRetries(ctx, cty.GetAttrPath("service_retry"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceRetry = serviceRetry
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -570,6 +629,21 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 				}
 
+				if _, ok := s[names.AttrProviderAccount]; !ok {
+					// Inject a top-level "provider_account" attribute.
+					providerAccountSchema := attribute.DataSourceProviderAccount()
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrProviderAccount] = providerAccountSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrProviderAccount] = providerAccountSchema
+					}
+				}
+
 				if v.IsValidateOverrideInPartition {
 					interceptors = append(interceptors, interceptorInvocation{
 						when:        Before,
@@ -577,6 +651,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						interceptor: dataSourceValidateRegion(),
 					})
 				}
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Read,
+					interceptor: dataSourceValidateAccount(),
+				})
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        After,
 					why:         Read,
@@ -594,15 +673,21 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			opts := wrappedDataSourceOptions{
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideAccount, overrideRegion string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok {
 							overrideRegion = region.(string)
 						}
+						if account, ok := getAttribute(names.AttrProviderAccount); ok {
+							overrideAccount = account.(string)
+						}
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
+					if overrideAccount != "" {
+						ctx = conns.WithOverrideAccount(ctx, overrideAccount)
+					}
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -676,6 +761,21 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 				}
 
+				if _, ok := s[names.AttrProviderAccount]; !ok {
+					// Inject a top-level "provider_account" attribute.
+					providerAccountSchema := attribute.ProviderAccount()
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrProviderAccount] = providerAccountSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrProviderAccount] = providerAccountSchema
+					}
+				}
+
 				if v.IsValidateOverrideInPartition {
 					interceptors = append(interceptors, interceptorInvocation{
 						when:        Before,
@@ -683,6 +783,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						interceptor: resourceValidateRegion(),
 					})
 				}
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: resourceValidateAccount(),
+				})
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before,
 					why:         CustomizeDiff,
@@ -701,6 +806,8 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: forceNewIfRegionChanges(),
 				})
+				// The account is removed from the end of the import ID before the Region.
+				interceptors = append(interceptors, resourceImportAccount())
 				if resource.Identity.HasInherentRegion() {
 					interceptors = append(interceptors, resourceImportRegionNoDefault())
 				} else {
@@ -726,6 +833,14 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			if v := resource.Permissions; len(v.Create) > 0 || len(v.Update) > 0 {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validatePermissions(v),
+				})
+			}
+
 			if len(resource.Identity.Attributes) > 0 {
 				r.Identity = newResourceIdentity(resource.Identity)
 
@@ -764,15 +879,21 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideAccount, overrideRegion string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
 							overrideRegion = region.(string)
 						}
+						if account, ok := getAttribute(names.AttrProviderAccount); ok && account != nil {
+							overrideAccount = account.(string)
+						}
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					if overrideAccount != "" {
+						ctx = conns.WithOverrideAccount(ctx, overrideAccount)
+					}
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -806,6 +927,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := s[names.AttrProviderAccount]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrProviderAccount, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
@@ -833,6 +958,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := s[names.AttrProviderAccount]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrProviderAccount, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(resource.Tags) {
@@ -870,6 +999,29 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+func accountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with named AWS accounts that resources can select via the top-level `provider_account` argument.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"assume_role": assumeRoleSchema(),
+				names.AttrName: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the account.",
+				},
+				names.AttrRegion: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The region where AWS operations for the account will take place. Defaults to the provider's region.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -997,6 +1149,89 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func serviceRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with per-service settings that override the provider's API retry and rate limiting configuration.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times an AWS API request to the service is attempted. Overrides `max_retries`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between retries of an AWS API request to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"requests_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of AWS API requests per second sent to the service, including retries.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Additional AWS API error codes for which requests to the service are retried.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service package name, e.g. `ec2` or `route53`.",
+				},
+			},
+		},
+	}
+}
+
+func expandAccounts(ctx context.Context, path cty.Path, tfList []any) (map[string]conns.AccountConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string]conns.AccountConfig)
+
+	for i, v := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if name == "" {
+			diags = append(diags, errs.NewAttributeRequiredError(path, names.AttrName))
+			continue
+		}
+		if _, ok := result[name]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(names.AttrName), "Duplicate account name: %q", name))
+			continue
+		}
+
+		var account conns.AccountConfig
+
+		if v, ok := tfMap["assume_role"].([]any); ok && len(v) > 0 {
+			assumeRoles, dg := expandAssumeRoles(ctx, path.GetAttr("assume_role"), v)
+			diags = append(diags, dg...)
+			if dg.HasError() {
+				continue
+			}
+			account.AssumeRole = assumeRoles
+		}
+
+		if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+			account.Region = v
+		}
+
+		result[name] = account
+	}
+
+	return result, diags
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -1106,6 +1341,53 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func expandServiceRetries(_ context.Context, path cty.Path, tfList []any) (map[string]conns.ServiceRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string]conns.ServiceRetryConfig)
+	servicePackageNames := names.ProviderPackages()
+
+	for i, v := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := tfMap["service"].(string)
+		if !slices.Contains(servicePackageNames, servicePackageName) {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Unknown service package name: %q", servicePackageName))
+			continue
+		}
+		if _, ok := result[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("service"), "Duplicate service package name: %q", servicePackageName))
+			continue
+		}
+
+		var serviceRetryConfig conns.ServiceRetryConfig
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v > 0 {
+			serviceRetryConfig.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			serviceRetryConfig.MaxBackoff = duration
+		}
+
+		if v, ok := tfMap["requests_per_second"].(int); ok && v > 0 {
+			serviceRetryConfig.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			serviceRetryConfig.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		result[servicePackageName] = serviceRetryConfig
+	}
+
+	return result, diags
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1188,6 +1470,58 @@ func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyCon
 	return nil, nil
 }
 
+func expandPermissionSimulation(path cty.Path, severity string) (string, diag.Diagnostics) {
+	envSeverity := os.Getenv(permissionsim.SeverityEnvVar)
+	switch {
+	case severity != "" && severity != "disabled":
+		return severity, validatePermissionSimulationSeverity(path, severity)
+	case envSeverity != "" && envSeverity != "disabled" && severity != "disabled":
+		return envSeverity, validatePermissionSimulationSeverityEnvVar(envSeverity)
+	}
+
+	return "", nil
+}
+
+func expandReadCacheTTL(ttl string) (time.Duration, diag.Diagnostics) {
+	if ttl != "" {
+		// Validated by the schema.
+		duration, _ := time.ParseDuration(ttl)
+		return duration, nil
+	}
+
+	if v := os.Getenv(conns.ReadCacheTTLEnvVar); v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, diag.Diagnostics{errs.NewErrorDiagnostic(
+				summaryInvalidEnvironmentVariableValue,
+				fmt.Sprintf("%s must be a valid duration: %s", conns.ReadCacheTTLEnvVar, err),
+			)}
+		}
+		return duration, nil
+	}
+
+	return 0, nil
+}
+
+func validatePermissionSimulationSeverity(path cty.Path, s string) diag.Diagnostics {
+	switch s {
+	case "error", "warning", "disabled":
+		return nil
+	}
+	return diag.Diagnostics{errs.NewInvalidValueAttributeError(path, `Must be one of "error", "warning", or "disabled"`)}
+}
+
+func validatePermissionSimulationSeverityEnvVar(s string) diag.Diagnostics {
+	switch s {
+	case "error", "warning", "disabled":
+		return nil
+	}
+	return diag.Diagnostics{errs.NewErrorDiagnostic(
+		summaryInvalidEnvironmentVariableValue,
+		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, permissionsim.SeverityEnvVar),
+	)}
+}
+
 func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
 	switch s {
 	case "error", "warning", "disabled":
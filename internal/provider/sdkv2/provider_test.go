@@ -7,12 +7,17 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissionsim"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -232,6 +237,215 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceRetries(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("service_retry")
+	testCases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.ServiceRetryConfig
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.ServiceRetryConfig{},
+		},
+		"all arguments": {
+			tfList: []any{
+				map[string]any{
+					"max_attempts":          5,
+					"max_backoff":           "30s",
+					"requests_per_second":   3,
+					"retryable_error_codes": schema.NewSet(schema.HashString, []any{"ConcurrentModificationException"}),
+					"service":               names.Route53,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.Route53: {
+					MaxAttempts:         5,
+					MaxBackoff:          30 * time.Second,
+					RequestsPerSecond:   3,
+					RetryableErrorCodes: []string{"ConcurrentModificationException"},
+				},
+			},
+		},
+		"multiple services": {
+			tfList: []any{
+				map[string]any{
+					"max_attempts": 50,
+					"service":      names.Organizations,
+				},
+				map[string]any{
+					"requests_per_second": 10,
+					"service":             names.EC2,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.EC2: {
+					RequestsPerSecond: 10,
+				},
+				names.Organizations: {
+					MaxAttempts: 50,
+				},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"service": "notaservice",
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(0).GetAttr("service"), `Unknown service package name: "notaservice"`),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"max_attempts": 5,
+					"service":      names.Route53,
+				},
+				map[string]any{
+					"max_attempts": 10,
+					"service":      names.Route53,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.Route53: {
+					MaxAttempts: 5,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(1).GetAttr("service"), `Duplicate service package name: "route53"`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceRetries(t.Context(), path, testCase.tfList)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAccounts(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("account")
+	testCases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.AccountConfig
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.AccountConfig{},
+		},
+		"all arguments": {
+			tfList: []any{
+				map[string]any{
+					"assume_role": []any{
+						map[string]any{
+							"role_arn":     "arn:aws:iam::123456789012:role/production", //lintignore:AWSAT005
+							"session_name": "terraform",
+						},
+					},
+					names.AttrName:   "production",
+					names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				},
+			},
+			expected: map[string]conns.AccountConfig{
+				"production": {
+					AssumeRole: []awsbase.AssumeRole{
+						{
+							RoleARN:     "arn:aws:iam::123456789012:role/production", //lintignore:AWSAT005
+							SessionName: "terraform",
+						},
+					},
+					Region: "eu-west-1", //lintignore:AWSAT003
+				},
+			},
+		},
+		"multiple accounts": {
+			tfList: []any{
+				map[string]any{
+					names.AttrName: "production",
+				},
+				map[string]any{
+					names.AttrName:   "staging",
+					names.AttrRegion: "us-west-2", //lintignore:AWSAT003
+				},
+			},
+			expected: map[string]conns.AccountConfig{
+				"production": {},
+				"staging": {
+					Region: "us-west-2", //lintignore:AWSAT003
+				},
+			},
+		},
+		"missing role_arn": {
+			tfList: []any{
+				map[string]any{
+					"assume_role": []any{
+						map[string]any{
+							"session_name": "terraform",
+						},
+					},
+					names.AttrName: "production",
+				},
+			},
+			expected: map[string]conns.AccountConfig{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(path.IndexInt(0).GetAttr("assume_role").IndexInt(0), "role_arn"),
+			},
+		},
+		"duplicate name": {
+			tfList: []any{
+				map[string]any{
+					names.AttrName: "production",
+				},
+				map[string]any{
+					names.AttrName:   "production",
+					names.AttrRegion: "us-west-2", //lintignore:AWSAT003
+				},
+			},
+			expected: map[string]conns.AccountConfig{
+				"production": {},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(1).GetAttr(names.AttrName), `Duplicate account name: "production"`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandAccounts(t.Context(), path, testCase.tfList)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
@@ -425,6 +639,91 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandPermissionSimulation(t *testing.T) { //nolint:paralleltest
+	testcases := map[string]struct {
+		severity         string
+		envvars          map[string]string
+		expectedSeverity string
+		expectError      bool
+	}{
+		"unset": {
+			envvars:          map[string]string{},
+			expectedSeverity: "",
+		},
+		"config": {
+			severity:         "warning",
+			envvars:          map[string]string{},
+			expectedSeverity: "warning",
+		},
+		"config disabled": {
+			severity:         "disabled",
+			envvars:          map[string]string{},
+			expectedSeverity: "",
+		},
+		"config invalid": {
+			severity:    "fatal",
+			envvars:     map[string]string{},
+			expectError: true,
+		},
+		"envvar": {
+			envvars: map[string]string{
+				permissionsim.SeverityEnvVar: "error",
+			},
+			expectedSeverity: "error",
+		},
+		"envvar disabled": {
+			envvars: map[string]string{
+				permissionsim.SeverityEnvVar: "disabled",
+			},
+			expectedSeverity: "",
+		},
+		"envvar invalid": {
+			envvars: map[string]string{
+				permissionsim.SeverityEnvVar: "fatal",
+			},
+			expectError: true,
+		},
+		"config overrides envvar": {
+			severity: "warning",
+			envvars: map[string]string{
+				permissionsim.SeverityEnvVar: "error",
+			},
+			expectedSeverity: "warning",
+		},
+		"config disabled overrides envvar": {
+			severity: "disabled",
+			envvars: map[string]string{
+				permissionsim.SeverityEnvVar: "error",
+			},
+			expectedSeverity: "",
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			severity, diags := expandPermissionSimulation(cty.GetAttrPath("permission_simulation"), testcase.severity)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("expected error: %t, got diagnostics: %v", want, diags)
+			}
+			if testcase.expectError {
+				return
+			}
+
+			if got, want := severity, testcase.expectedSeverity; got != want {
+				t.Errorf("severity = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
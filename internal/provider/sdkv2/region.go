@@ -9,6 +9,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -130,7 +131,11 @@ func importRegion() importInterceptor {
 					d.SetId(matches[1])
 					d.Set(names.AttrRegion, matches[2])
 				} else {
-					d.Set(names.AttrRegion, c.AwsConfig(ctx).Region)
+					// Default to the Region of any named account set from the import ID.
+					if account, ok := d.GetOk(names.AttrProviderAccount); ok {
+						ctx = conns.WithOverrideAccount(ctx, account.(string))
+					}
+					d.Set(names.AttrRegion, c.Region(ctx))
 				}
 			}
 		}
@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudfront_connection_group", name="Connection Group")
+// @Tags(identifierAttribute="arn")
+func newConnectionGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &connectionGroupResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type connectionGroupResource struct {
+	framework.ResourceWithModel[connectionGroupResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *connectionGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"anycast_ip_list_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ipv6_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"is_default": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routing_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *connectionGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data connectionGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	input := &cloudfront.CreateConnectionGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		input.Tags = &awstypes.Tags{
+			Items: tags,
+		}
+	}
+
+	output, err := conn.CreateConnectionGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudFront Connection Group", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.ConnectionGroup.Id)
+
+	outputGet, err := waitConnectionGroupDeployed(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Connection Group (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, outputGet.ConnectionGroup, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ETag = fwflex.StringToFramework(ctx, outputGet.ETag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *connectionGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data connectionGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	output, err := findConnectionGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Connection Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.ConnectionGroup, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *connectionGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new connectionGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	if !new.AnycastIPListID.Equal(old.AnycastIPListID) ||
+		!new.Enabled.Equal(old.Enabled) ||
+		!new.IPv6Enabled.Equal(old.IPv6Enabled) {
+		input := &cloudfront.UpdateConnectionGroupInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Id = new.ID.ValueStringPointer()
+		// Use state ETag value. The planned value will be unknown.
+		input.IfMatch = old.ETag.ValueStringPointer()
+
+		_, err := conn.UpdateConnectionGroup(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Connection Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitConnectionGroupDeployed(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Connection Group (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	} else {
+		new.ETag = old.ETag
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *connectionGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data connectionGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	id := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A connection group must be disabled before it can be deleted.
+	output, err := findConnectionGroupByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Connection Group (%s)", id), err.Error())
+
+		return
+	}
+
+	etag := aws.ToString(output.ETag)
+
+	if aws.ToBool(output.ConnectionGroup.Enabled) {
+		input := &cloudfront.UpdateConnectionGroupInput{
+			AnycastIpListId: output.ConnectionGroup.AnycastIpListId,
+			Enabled:         aws.Bool(false),
+			Id:              aws.String(id),
+			IfMatch:         aws.String(etag),
+			Ipv6Enabled:     output.ConnectionGroup.Ipv6Enabled,
+		}
+
+		_, err := conn.UpdateConnectionGroup(ctx, input)
+
+		if errs.IsA[*awstypes.EntityNotFound](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("disabling CloudFront Connection Group (%s)", id), err.Error())
+
+			return
+		}
+
+		output, err := waitConnectionGroupDeployed(ctx, conn, id, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Connection Group (%s) disable", id), err.Error())
+
+			return
+		}
+
+		etag = aws.ToString(output.ETag)
+	}
+
+	input := &cloudfront.DeleteConnectionGroupInput{
+		Id:      aws.String(id),
+		IfMatch: aws.String(etag),
+	}
+
+	_, err = conn.DeleteConnectionGroup(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return
+	}
+
+	if errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err) {
+		etag, err = connectionGroupETag(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Connection Group (%s)", id), err.Error())
+
+			return
+		}
+
+		input.IfMatch = aws.String(etag)
+
+		_, err = conn.DeleteConnectionGroup(ctx, input)
+
+		if errs.IsA[*awstypes.EntityNotFound](err) {
+			return
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront Connection Group (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitConnectionGroupDeleted(ctx, conn, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Connection Group (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func connectionGroupETag(ctx context.Context, conn *cloudfront.Client, id string) (string, error) {
+	output, err := findConnectionGroupByID(ctx, conn, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.ETag), nil
+}
+
+func findConnectionGroupByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetConnectionGroupOutput, error) {
+	input := &cloudfront.GetConnectionGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	return findConnectionGroup(ctx, conn, input)
+}
+
+func findConnectionGroup(ctx context.Context, conn *cloudfront.Client, input *cloudfront.GetConnectionGroupInput) (*cloudfront.GetConnectionGroupOutput, error) {
+	output, err := conn.GetConnectionGroup(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConnectionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func connectionGroupStatus(ctx context.Context, conn *cloudfront.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findConnectionGroupByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.ConnectionGroup.Status), nil
+	}
+}
+
+func waitConnectionGroupDeployed(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetConnectionGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectionGroupStatusInProgress},
+		Target:  []string{connectionGroupStatusDeployed},
+		Refresh: connectionGroupStatus(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetConnectionGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionGroupDeleted(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetConnectionGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectionGroupStatusDeployed, connectionGroupStatusInProgress},
+		Target:  []string{},
+		Refresh: connectionGroupStatus(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetConnectionGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type connectionGroupResourceModel struct {
+	AnycastIPListID types.String   `tfsdk:"anycast_ip_list_id"`
+	ARN             types.String   `tfsdk:"arn"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	ETag            types.String   `tfsdk:"etag"`
+	ID              types.String   `tfsdk:"id"`
+	IPv6Enabled     types.Bool     `tfsdk:"ipv6_enabled"`
+	IsDefault       types.Bool     `tfsdk:"is_default"`
+	Name            types.String   `tfsdk:"name"`
+	RoutingEndpoint types.String   `tfsdk:"routing_endpoint"`
+	Tags            tftags.Map     `tfsdk:"tags"`
+	TagsAll         tftags.Map     `tfsdk:"tags_all"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
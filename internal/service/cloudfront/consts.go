@@ -29,3 +29,13 @@ const (
 	vpcOriginStatusDeployed  = "Deployed"
 	vpcOriginStatusDeploying = "Deploying"
 )
+
+const (
+	connectionGroupStatusDeployed   = "Deployed"
+	connectionGroupStatusInProgress = "InProgress"
+)
+
+const (
+	distributionTenantStatusDeployed   = "Deployed"
+	distributionTenantStatusInProgress = "InProgress"
+)
@@ -0,0 +1,636 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudfront_distribution_tenant", name="Distribution Tenant")
+// @Tags(identifierAttribute="arn")
+func newDistributionTenantResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &distributionTenantResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type distributionTenantResource struct {
+	framework.ResourceWithModel[distributionTenantResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *distributionTenantResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connection_group_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"distribution_id": schema.StringAttribute{
+				Required: true,
+			},
+			"domains": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"customizations": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customizationsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrCertificate: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[certificateModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"geo_restriction": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[geoRestrictionCustomizationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"locations": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"restriction_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GeoRestrictionType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"web_acl": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[webACLCustomizationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAction: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomizationActionType](),
+										Required:   true,
+									},
+									names.AttrARN: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"managed_certificate_request": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[managedCertificateRequestModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_transparency_logging_preference": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CertificateTransparencyLoggingPreference](),
+							Optional:   true,
+						},
+						"primary_domain_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"validation_token_host": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationTokenHost](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			names.AttrParameter: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[distributionTenantParameterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *distributionTenantResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data distributionTenantResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	input := &cloudfront.CreateDistributionTenantInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithIgnoredFieldNamesAppend("Domains"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Domains = expandDomainItems(ctx, data.Domains)
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		input.Tags = &awstypes.Tags{
+			Items: tags,
+		}
+	}
+
+	output, err := conn.CreateDistributionTenant(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Distribution Tenant (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.DistributionTenant.Id)
+
+	outputGet, err := waitDistributionTenantDeployed(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution Tenant (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenDistributionTenant(ctx, outputGet, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *distributionTenantResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data distributionTenantResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	output, err := findDistributionTenantByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Distribution Tenant (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenDistributionTenant(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *distributionTenantResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new distributionTenantResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	if !new.ConnectionGroupID.Equal(old.ConnectionGroupID) ||
+		!new.Customizations.Equal(old.Customizations) ||
+		!new.DistributionID.Equal(old.DistributionID) ||
+		!new.Domains.Equal(old.Domains) ||
+		!new.Enabled.Equal(old.Enabled) ||
+		!new.ManagedCertificateRequest.Equal(old.ManagedCertificateRequest) ||
+		!new.Parameters.Equal(old.Parameters) {
+		input := &cloudfront.UpdateDistributionTenantInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, fwflex.WithIgnoredFieldNamesAppend("Domains"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Domains = expandDomainItems(ctx, new.Domains)
+		input.Id = new.ID.ValueStringPointer()
+		// Only request a managed certificate when the request changes.
+		if new.ManagedCertificateRequest.Equal(old.ManagedCertificateRequest) {
+			input.ManagedCertificateRequest = nil
+		}
+		// Use state ETag value. The planned value will be unknown.
+		input.IfMatch = old.ETag.ValueStringPointer()
+
+		// The update replaces the tenant's customizations and parameters.
+		if input.Customizations == nil {
+			input.Customizations = &awstypes.Customizations{}
+		}
+		if input.Parameters == nil {
+			input.Parameters = []awstypes.Parameter{}
+		}
+
+		_, err := conn.UpdateDistributionTenant(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Distribution Tenant (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitDistributionTenantDeployed(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution Tenant (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(flattenDistributionTenant(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.ETag = old.ETag
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *distributionTenantResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data distributionTenantResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	id := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A distribution tenant must be disabled before it can be deleted.
+	output, err := findDistributionTenantByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Distribution Tenant (%s)", id), err.Error())
+
+		return
+	}
+
+	etag := aws.ToString(output.ETag)
+
+	if tenant := output.DistributionTenant; aws.ToBool(tenant.Enabled) {
+		input := &cloudfront.UpdateDistributionTenantInput{
+			ConnectionGroupId: tenant.ConnectionGroupId,
+			Customizations:    tenant.Customizations,
+			DistributionId:    tenant.DistributionId,
+			Enabled:           aws.Bool(false),
+			Id:                aws.String(id),
+			IfMatch:           aws.String(etag),
+			Parameters:        tenant.Parameters,
+		}
+		for _, v := range tenant.Domains {
+			input.Domains = append(input.Domains, awstypes.DomainItem{Domain: v.Domain})
+		}
+
+		_, err := conn.UpdateDistributionTenant(ctx, input)
+
+		if errs.IsA[*awstypes.EntityNotFound](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("disabling CloudFront Distribution Tenant (%s)", id), err.Error())
+
+			return
+		}
+
+		output, err := waitDistributionTenantDeployed(ctx, conn, id, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution Tenant (%s) disable", id), err.Error())
+
+			return
+		}
+
+		etag = aws.ToString(output.ETag)
+	}
+
+	input := &cloudfront.DeleteDistributionTenantInput{
+		Id:      aws.String(id),
+		IfMatch: aws.String(etag),
+	}
+
+	_, err = conn.DeleteDistributionTenant(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return
+	}
+
+	if errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err) {
+		etag, err = distributionTenantETag(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Distribution Tenant (%s)", id), err.Error())
+
+			return
+		}
+
+		input.IfMatch = aws.String(etag)
+
+		_, err = conn.DeleteDistributionTenant(ctx, input)
+
+		if errs.IsA[*awstypes.EntityNotFound](err) {
+			return
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront Distribution Tenant (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitDistributionTenantDeleted(ctx, conn, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution Tenant (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func distributionTenantETag(ctx context.Context, conn *cloudfront.Client, id string) (string, error) {
+	output, err := findDistributionTenantByID(ctx, conn, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.ETag), nil
+}
+
+func findDistributionTenantByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionTenantOutput, error) {
+	input := &cloudfront.GetDistributionTenantInput{
+		Identifier: aws.String(id),
+	}
+
+	return findDistributionTenant(ctx, conn, input)
+}
+
+func findDistributionTenant(ctx context.Context, conn *cloudfront.Client, input *cloudfront.GetDistributionTenantInput) (*cloudfront.GetDistributionTenantOutput, error) {
+	output, err := conn.GetDistributionTenant(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DistributionTenant == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func distributionTenantStatus(ctx context.Context, conn *cloudfront.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDistributionTenantByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.DistributionTenant.Status), nil
+	}
+}
+
+func waitDistributionTenantDeployed(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetDistributionTenantOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{distributionTenantStatusInProgress},
+		Target:  []string{distributionTenantStatusDeployed},
+		Refresh: distributionTenantStatus(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetDistributionTenantOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDistributionTenantDeleted(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetDistributionTenantOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{distributionTenantStatusDeployed, distributionTenantStatusInProgress},
+		Target:  []string{},
+		Refresh: distributionTenantStatus(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetDistributionTenantOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenDistributionTenant(ctx context.Context, output *cloudfront.GetDistributionTenantOutput, data *distributionTenantResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output.DistributionTenant, data, fwflex.WithIgnoredFieldNamesAppend("Domains"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// An empty customizations object is returned for tenants without customizations.
+	if v := output.DistributionTenant.Customizations; v == nil || (v.Certificate == nil && v.GeoRestrictions == nil && v.WebAcl == nil) {
+		data.Customizations = fwtypes.NewListNestedObjectValueOfNull[customizationsModel](ctx)
+	}
+
+	domains := make([]string, 0, len(output.DistributionTenant.Domains))
+	for _, v := range output.DistributionTenant.Domains {
+		domains = append(domains, aws.ToString(v.Domain))
+	}
+	data.Domains = fwflex.FlattenFrameworkStringValueSetOfString(ctx, domains)
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+
+	return diags
+}
+
+func expandDomainItems(ctx context.Context, v fwtypes.SetOfString) []awstypes.DomainItem {
+	var apiObjects []awstypes.DomainItem
+
+	for _, domain := range fwflex.ExpandFrameworkStringValueSet(ctx, v) {
+		apiObjects = append(apiObjects, awstypes.DomainItem{
+			Domain: aws.String(domain),
+		})
+	}
+
+	return apiObjects
+}
+
+type distributionTenantResourceModel struct {
+	ARN                       types.String                                                     `tfsdk:"arn"`
+	ConnectionGroupID         types.String                                                     `tfsdk:"connection_group_id"`
+	Customizations            fwtypes.ListNestedObjectValueOf[customizationsModel]             `tfsdk:"customizations"`
+	DistributionID            types.String                                                     `tfsdk:"distribution_id"`
+	Domains                   fwtypes.SetOfString                                              `tfsdk:"domains"`
+	Enabled                   types.Bool                                                       `tfsdk:"enabled"`
+	ETag                      types.String                                                     `tfsdk:"etag"`
+	ID                        types.String                                                     `tfsdk:"id"`
+	ManagedCertificateRequest fwtypes.ListNestedObjectValueOf[managedCertificateRequestModel]  `tfsdk:"managed_certificate_request"`
+	Name                      types.String                                                     `tfsdk:"name"`
+	Parameters                fwtypes.SetNestedObjectValueOf[distributionTenantParameterModel] `tfsdk:"parameter"`
+	Status                    types.String                                                     `tfsdk:"status"`
+	Tags                      tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                       `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type customizationsModel struct {
+	Certificate     fwtypes.ListNestedObjectValueOf[certificateModel]                 `tfsdk:"certificate"`
+	GeoRestrictions fwtypes.ListNestedObjectValueOf[geoRestrictionCustomizationModel] `tfsdk:"geo_restriction"`
+	WebACL          fwtypes.ListNestedObjectValueOf[webACLCustomizationModel]         `tfsdk:"web_acl"`
+}
+
+type certificateModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type geoRestrictionCustomizationModel struct {
+	Locations       fwtypes.SetOfString                             `tfsdk:"locations"`
+	RestrictionType fwtypes.StringEnum[awstypes.GeoRestrictionType] `tfsdk:"restriction_type"`
+}
+
+type webACLCustomizationModel struct {
+	Action fwtypes.StringEnum[awstypes.CustomizationActionType] `tfsdk:"action"`
+	ARN    types.String                                         `tfsdk:"arn"`
+}
+
+type managedCertificateRequestModel struct {
+	CertificateTransparencyLoggingPreference fwtypes.StringEnum[awstypes.CertificateTransparencyLoggingPreference] `tfsdk:"certificate_transparency_logging_preference"`
+	PrimaryDomainName                        types.String                                                          `tfsdk:"primary_domain_name"`
+	ValidationTokenHost                      fwtypes.StringEnum[awstypes.ValidationTokenHost]                      `tfsdk:"validation_token_host"`
+}
+
+type distributionTenantParameterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
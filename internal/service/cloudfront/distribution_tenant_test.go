@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontDistributionTenant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var tenant awstypes.DistributionTenant
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomain().String()
+	resourceName := "aws_cloudfront_distribution_tenant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionTenantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionTenantConfig_basic(t, rName, domain, "origin1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &tenant),
+					acctest.CheckResourceAttrGlobalARNFormat(ctx, resourceName, names.AttrARN, "cloudfront", "distribution-tenant/{id}"),
+					resource.TestCheckResourceAttrSet(resourceName, "connection_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.0.certificate.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "customizations.0.certificate.0.arn", "aws_acm_certificate.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", "aws_cloudfront_multitenant_distribution.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", domain),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "origin",
+						names.AttrValue: "origin1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+			{
+				Config: testAccDistributionTenantConfig_basic(t, rName, domain, "origin2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &tenant),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "origin",
+						names.AttrValue: "origin2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistributionTenant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var tenant awstypes.DistributionTenant
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomain().String()
+	resourceName := "aws_cloudfront_distribution_tenant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionTenantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionTenantConfig_basic(t, rName, domain, "origin1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &tenant),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceDistributionTenant, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistributionTenant_connectionGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var tenant awstypes.DistributionTenant
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomain().String()
+	resourceName := "aws_cloudfront_distribution_tenant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionTenantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionTenantConfig_connectionGroup(t, rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &tenant),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_group_id", "aws_cloudfront_connection_group.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionTenantExists(ctx context.Context, n string, v *awstypes.DistributionTenant) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		output, err := tfcloudfront.FindDistributionTenantByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output.DistributionTenant
+
+		return nil
+	}
+}
+
+func testAccCheckDistributionTenantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_distribution_tenant" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindDistributionTenantByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudFront Distribution Tenant %s still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccDistributionTenantConfig_base(t *testing.T, rName, domain string) string {
+	return acctest.ConfigCompose(
+		testAccDistributionViewerCertificateACMCertificateARNBaseConfig(t, domain),
+		testAccMultiTenantDistributionConfig_tenantConfig(rName, "origin"),
+	)
+}
+
+func testAccDistributionTenantConfig_basic(t *testing.T, rName, domain, origin string) string {
+	return acctest.ConfigCompose(testAccDistributionTenantConfig_base(t, rName, domain), fmt.Sprintf(`
+resource "aws_cloudfront_distribution_tenant" "test" {
+  name            = %[1]q
+  distribution_id = aws_cloudfront_multitenant_distribution.test.id
+  domains         = [%[2]q]
+
+  customizations {
+    certificate {
+      arn = aws_acm_certificate.test.arn
+    }
+  }
+
+  parameter {
+    name  = "origin"
+    value = %[3]q
+  }
+}
+`, rName, domain, origin))
+}
+
+func testAccDistributionTenantConfig_connectionGroup(t *testing.T, rName, domain string) string {
+	return acctest.ConfigCompose(testAccDistributionTenantConfig_base(t, rName, domain), fmt.Sprintf(`
+resource "aws_cloudfront_connection_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfront_distribution_tenant" "test" {
+  name                = %[1]q
+  distribution_id     = aws_cloudfront_multitenant_distribution.test.id
+  connection_group_id = aws_cloudfront_connection_group.test.id
+  domains             = [%[2]q]
+
+  customizations {
+    certificate {
+      arn = aws_acm_certificate.test.arn
+    }
+  }
+
+  parameter {
+    name  = "origin"
+    value = "origin1"
+  }
+}
+`, rName, domain))
+}
@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_multitenant_distribution", name="Multi-tenant Distribution")
+// @Tags(identifierAttribute="arn")
+func resourceMultiTenantDistribution() *schema.Resource {
+	//lintignore:R011
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiTenantDistributionCreate,
+		ReadWithoutTimeout:   resourceMultiTenantDistributionRead,
+		UpdateWithoutTimeout: resourceMultiTenantDistributionUpdate,
+		DeleteWithoutTimeout: resourceMultiTenantDistributionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				// Set non API attributes to their Default settings in the schema
+				d.Set("retain_on_delete", false)
+				d.Set("wait_for_deployment", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: func() map[string]*schema.Schema {
+			// The Multi-tenant Distribution schema is based on the Distribution schema.
+			s := resourceDistribution().SchemaMap()
+
+			// Remove attributes that are configured per distribution tenant or connection group,
+			// or that aren't supported by multi-tenant distributions.
+			delete(s, "aliases")
+			delete(s, "anycast_ip_list_id")
+			delete(s, "continuous_deployment_policy_id")
+			delete(s, "is_ipv6_enabled")
+			delete(s, "price_class")
+			delete(s, "staging")
+
+			s["tenant_config"] = &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"string_schema": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrComment: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrDefaultValue: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"required": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			return s
+		}(),
+	}
+}
+
+func resourceMultiTenantDistributionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	input := cloudfront.CreateDistributionWithTagsInput{
+		DistributionConfigWithTags: &awstypes.DistributionConfigWithTags{
+			DistributionConfig: expandMultiTenantDistributionConfig(d),
+			Tags:               &awstypes.Tags{Items: []awstypes.Tag{}},
+		},
+	}
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		input.DistributionConfigWithTags.Tags.Items = tags
+	}
+
+	// ACM and IAM certificate eventual consistency.
+	// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
+	const (
+		timeout = 1 * time.Minute
+	)
+	outputRaw, err := tfresource.RetryWhenIsA[any, *awstypes.InvalidViewerCertificate](ctx, timeout, func(ctx context.Context) (any, error) {
+		return conn.CreateDistributionWithTags(ctx, &input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Multi-tenant Distribution: %s", err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*cloudfront.CreateDistributionWithTagsOutput).Distribution.Id))
+
+	if d.Get("wait_for_deployment").(bool) {
+		if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Multi-tenant Distribution (%s) deploy: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMultiTenantDistributionRead(ctx, d, meta)...)
+}
+
+func resourceMultiTenantDistributionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	output, err := findDistributionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Multi-tenant Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Multi-tenant Distribution (%s): %s", d.Id(), err)
+	}
+
+	distributionConfig := output.Distribution.DistributionConfig
+	if distributionConfig.ConnectionMode != awstypes.ConnectionModeTenantOnly {
+		return sdkdiag.AppendErrorf(diags, "CloudFront Distribution (%s) is not a multi-tenant distribution", d.Id())
+	}
+
+	d.Set(names.AttrARN, output.Distribution.ARN)
+	d.Set("caller_reference", distributionConfig.CallerReference)
+	if aws.ToString(distributionConfig.Comment) != "" {
+		d.Set(names.AttrComment, distributionConfig.Comment)
+	}
+	if distributionConfig.CustomErrorResponses != nil {
+		if err := d.Set("custom_error_response", flattenCustomErrorResponses(distributionConfig.CustomErrorResponses)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting custom_error_response: %s", err)
+		}
+	}
+	if err := d.Set("default_cache_behavior", []any{flattenDefaultCacheBehavior(distributionConfig.DefaultCacheBehavior)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting default_cache_behavior: %s", err)
+	}
+	d.Set("default_root_object", distributionConfig.DefaultRootObject)
+	d.Set(names.AttrDomainName, output.Distribution.DomainName)
+	d.Set(names.AttrEnabled, distributionConfig.Enabled)
+	d.Set("etag", output.ETag)
+	d.Set("http_version", distributionConfig.HttpVersion)
+	d.Set(names.AttrHostedZoneID, meta.(*conns.AWSClient).CloudFrontDistributionHostedZoneID(ctx))
+	d.Set("in_progress_validation_batches", output.Distribution.InProgressInvalidationBatches)
+	d.Set("last_modified_time", aws.String(output.Distribution.LastModifiedTime.String()))
+	if distributionConfig.Logging != nil {
+		d.Set("logging_v1_enabled", distributionConfig.Logging.Enabled)
+		if aws.ToBool(distributionConfig.Logging.Enabled) || aws.ToBool(distributionConfig.Logging.IncludeCookies) {
+			if err := d.Set("logging_config", flattenLoggingConfig(distributionConfig.Logging)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+			}
+		} else {
+			d.Set("logging_config", []any{})
+		}
+	} else {
+		d.Set("logging_v1_enabled", false)
+		d.Set("logging_config", []any{})
+	}
+	if distributionConfig.CacheBehaviors != nil {
+		if err := d.Set("ordered_cache_behavior", flattenCacheBehaviors(distributionConfig.CacheBehaviors)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ordered_cache_behavior: %s", err)
+		}
+	}
+	if aws.ToInt32(distributionConfig.Origins.Quantity) > 0 {
+		if err := d.Set("origin", flattenOrigins(distributionConfig.Origins)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting origin: %s", err)
+		}
+	}
+	if distributionConfig.OriginGroups != nil && aws.ToInt32(distributionConfig.OriginGroups.Quantity) > 0 {
+		if err := d.Set("origin_group", flattenOriginGroups(distributionConfig.OriginGroups)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting origin_group: %s", err)
+		}
+	}
+	if distributionConfig.Restrictions != nil {
+		if err := d.Set("restrictions", flattenRestrictions(distributionConfig.Restrictions)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting restrictions: %s", err)
+		}
+	}
+	d.Set(names.AttrStatus, output.Distribution.Status)
+	if err := d.Set("tenant_config", flattenTenantConfig(distributionConfig.TenantConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tenant_config: %s", err)
+	}
+	if err := d.Set("trusted_key_groups", flattenActiveTrustedKeyGroups(output.Distribution.ActiveTrustedKeyGroups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting trusted_key_groups: %s", err)
+	}
+	if err := d.Set("trusted_signers", flattenActiveTrustedSigners(output.Distribution.ActiveTrustedSigners)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting trusted_signers: %s", err)
+	}
+	if err := d.Set("viewer_certificate", flattenViewerCertificate(distributionConfig.ViewerCertificate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting viewer_certificate: %s", err)
+	}
+	d.Set("web_acl_id", distributionConfig.WebACLId)
+
+	return diags
+}
+
+func resourceMultiTenantDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := cloudfront.UpdateDistributionInput{
+			DistributionConfig: expandMultiTenantDistributionConfig(d),
+			Id:                 aws.String(d.Id()),
+			IfMatch:            aws.String(d.Get("etag").(string)),
+		}
+
+		// ACM and IAM certificate eventual consistency.
+		const (
+			timeout = 1 * time.Minute
+		)
+		_, err := tfresource.RetryWhenIsA[any, *awstypes.InvalidViewerCertificate](ctx, timeout, func(ctx context.Context) (any, error) {
+			return conn.UpdateDistribution(ctx, &input)
+		})
+
+		// Refresh our ETag if it is out of date and attempt update again.
+		if errs.IsA[*awstypes.PreconditionFailed](err) {
+			var etag string
+			etag, err = distroETag(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.IfMatch = aws.String(etag)
+
+			_, err = conn.UpdateDistribution(ctx, &input)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFront Multi-tenant Distribution (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Multi-tenant Distribution (%s) deploy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceMultiTenantDistributionRead(ctx, d, meta)...)
+}
+
+func resourceMultiTenantDistributionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.Get("retain_on_delete").(bool) {
+		log.Printf("[WARN] Removing CloudFront Multi-tenant Distribution ID %q with `retain_on_delete` set. Please delete this distribution manually.", d.Id())
+		return diags
+	}
+
+	err := deleteDistribution(ctx, conn, d.Id())
+
+	if errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err) || errs.IsA[*awstypes.DistributionNotDisabled](err) {
+		const (
+			timeout = 3 * time.Minute
+		)
+		_, err = tfresource.RetryWhenIsOneOf3[any, *awstypes.PreconditionFailed, *awstypes.InvalidIfMatchVersion, *awstypes.DistributionNotDisabled](ctx, timeout, func(ctx context.Context) (any, error) {
+			return nil, deleteDistribution(ctx, conn, d.Id())
+		})
+	}
+
+	if tfresource.NotFound(err) || errs.IsA[*awstypes.NoSuchDistribution](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func expandMultiTenantDistributionConfig(d *schema.ResourceData) *awstypes.DistributionConfig {
+	apiObject := &awstypes.DistributionConfig{
+		CacheBehaviors:       expandCacheBehaviors(d.Get("ordered_cache_behavior").([]any)),
+		CallerReference:      aws.String(id.UniqueId()),
+		Comment:              aws.String(d.Get(names.AttrComment).(string)),
+		ConnectionMode:       awstypes.ConnectionModeTenantOnly,
+		CustomErrorResponses: expandCustomErrorResponses(d.Get("custom_error_response").(*schema.Set).List()),
+		DefaultCacheBehavior: expandDefaultCacheBehavior(d.Get("default_cache_behavior").([]any)[0].(map[string]any)),
+		DefaultRootObject:    aws.String(d.Get("default_root_object").(string)),
+		Enabled:              aws.Bool(d.Get(names.AttrEnabled).(bool)),
+		HttpVersion:          awstypes.HttpVersion(d.Get("http_version").(string)),
+		Origins:              expandOrigins(d.Get("origin").(*schema.Set).List()),
+		WebACLId:             aws.String(d.Get("web_acl_id").(string)),
+	}
+
+	if v, ok := d.GetOk("caller_reference"); ok {
+		apiObject.CallerReference = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		apiObject.Logging = expandLoggingConfig(v.([]any)[0].(map[string]any))
+	} else {
+		apiObject.Logging = expandLoggingConfig(nil)
+	}
+
+	if v, ok := d.GetOk("origin_group"); ok {
+		apiObject.OriginGroups = expandOriginGroups(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("restrictions"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		apiObject.Restrictions = expandRestrictions(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("tenant_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		apiObject.TenantConfig = expandTenantConfig(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("viewer_certificate"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		apiObject.ViewerCertificate = expandViewerCertificate(v.([]any)[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandTenantConfig(tfMap map[string]any) *awstypes.TenantConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.TenantConfig{}
+
+	if v, ok := tfMap["parameter_definition"].([]any); ok && len(v) > 0 {
+		apiObject.ParameterDefinitions = expandParameterDefinitions(v)
+	}
+
+	return apiObject
+}
+
+func expandParameterDefinitions(tfList []any) []awstypes.ParameterDefinition {
+	var apiObjects []awstypes.ParameterDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ParameterDefinition{
+			Definition: &awstypes.ParameterDefinitionSchema{},
+			Name:       aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["string_schema"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Definition.StringSchema = expandStringSchemaConfig(v[0].(map[string]any))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandStringSchemaConfig(tfMap map[string]any) *awstypes.StringSchemaConfig {
+	apiObject := &awstypes.StringSchemaConfig{
+		Required: aws.Bool(tfMap["required"].(bool)),
+	}
+
+	if v, ok := tfMap[names.AttrComment].(string); ok && v != "" {
+		apiObject.Comment = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+		apiObject.DefaultValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTenantConfig(apiObject *awstypes.TenantConfig) []any {
+	if apiObject == nil || len(apiObject.ParameterDefinitions) == 0 {
+		return []any{}
+	}
+
+	tfList := make([]any, 0, len(apiObject.ParameterDefinitions))
+	for _, v := range apiObject.ParameterDefinitions {
+		tfMap := map[string]any{
+			names.AttrName: aws.ToString(v.Name),
+		}
+
+		if v.Definition != nil && v.Definition.StringSchema != nil {
+			tfMap["string_schema"] = []any{map[string]any{
+				names.AttrComment:      aws.ToString(v.Definition.StringSchema.Comment),
+				names.AttrDefaultValue: aws.ToString(v.Definition.StringSchema.DefaultValue),
+				"required":             aws.ToBool(v.Definition.StringSchema.Required),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []any{map[string]any{
+		"parameter_definition": tfList,
+	}}
+}
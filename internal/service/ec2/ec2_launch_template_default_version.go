@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_launch_template_default_version", name="Launch Template Default Version")
+func newLaunchTemplateDefaultVersionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &launchTemplateDefaultVersionResource{}
+
+	return r, nil
+}
+
+type launchTemplateDefaultVersionResource struct {
+	framework.ResourceWithModel[launchTemplateDefaultVersionResourceModel]
+	framework.WithImportByID
+}
+
+func (r *launchTemplateDefaultVersionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"launch_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"original_default_version": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *launchTemplateDefaultVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data launchTemplateDefaultVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	launchTemplateID := data.LaunchTemplateID.ValueString()
+	lt, err := findLaunchTemplateByID(ctx, conn, launchTemplateID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Launch Template (%s)", launchTemplateID), err.Error())
+
+		return
+	}
+
+	if err := modifyLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, data.Version.ValueInt64()); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Launch Template Default Version (%s)", launchTemplateID), err.Error())
+
+		return
+	}
+
+	// Set unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, launchTemplateID)
+	data.OriginalDefaultVersion = fwflex.Int64ToFramework(ctx, lt.DefaultVersionNumber)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *launchTemplateDefaultVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data launchTemplateDefaultVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	lt, err := findLaunchTemplateByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Launch Template Default Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.LaunchTemplateID = fwflex.StringToFramework(ctx, lt.LaunchTemplateId)
+	data.Version = fwflex.Int64ToFramework(ctx, lt.DefaultVersionNumber)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *launchTemplateDefaultVersionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new launchTemplateDefaultVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if err := modifyLaunchTemplateDefaultVersion(ctx, conn, new.ID.ValueString(), new.Version.ValueInt64()); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Launch Template Default Version (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *launchTemplateDefaultVersionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data launchTemplateDefaultVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources have no original default version to restore.
+	if data.OriginalDefaultVersion.IsNull() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	err := modifyLaunchTemplateDefaultVersion(ctx, conn, data.ID.ValueString(), data.OriginalDefaultVersion.ValueInt64())
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLaunchTemplateIdNotFound, errCodeInvalidLaunchTemplateIdVersionNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Launch Template Default Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type launchTemplateDefaultVersionResourceModel struct {
+	framework.WithRegionModel
+	ID                     types.String `tfsdk:"id"`
+	LaunchTemplateID       types.String `tfsdk:"launch_template_id"`
+	OriginalDefaultVersion types.Int64  `tfsdk:"original_default_version"`
+	Version                types.Int64  `tfsdk:"version"`
+}
+
+func modifyLaunchTemplateDefaultVersion(ctx context.Context, conn *ec2.Client, launchTemplateID string, version int64) error {
+	input := ec2.ModifyLaunchTemplateInput{
+		DefaultVersion:   flex.Int64ValueToString(version),
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	_, err := conn.ModifyLaunchTemplate(ctx, &input)
+
+	return err
+}
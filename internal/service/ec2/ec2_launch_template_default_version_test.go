@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2LaunchTemplateDefaultVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_launch_template_default_version.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateDefaultVersionConfig_basic(rName, "t3.micro", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, launchTemplateResourceName, &template),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, launchTemplateResourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, launchTemplateResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_id", launchTemplateResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "original_default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_default_version"},
+			},
+			{
+				// Creates version 2 and promotes it.
+				Config: testAccLaunchTemplateDefaultVersionConfig_basic(rName, "t3.small", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, launchTemplateResourceName, &template),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, launchTemplateResourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "original_default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+			{
+				// Rolls back to version 1.
+				Config: testAccLaunchTemplateDefaultVersionConfig_basic(rName, "t3.small", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, launchTemplateResourceName, &template),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, launchTemplateResourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchTemplateDefaultVersion(ctx context.Context, n string, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindLaunchTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToInt64(output.DefaultVersionNumber); got != expected {
+			return fmt.Errorf("EC2 Launch Template %s default version = %d, expected %d", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchTemplateDefaultVersionConfig_basic(rName, instanceType string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+}
+
+resource "aws_launch_template_default_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  version            = %[3]d
+}
+`, rName, instanceType, version)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// promoteLaunchTemplateVersionPollInterval defines polling cadence for promote launch template version action.
+const promoteLaunchTemplateVersionPollInterval = 5 * time.Second
+
+const (
+	launchTemplateDefaultVersionStatusPending  = "PENDING"
+	launchTemplateDefaultVersionStatusPromoted = "PROMOTED"
+)
+
+// @Action(aws_ec2_promote_launch_template_version, name="Promote Launch Template Version")
+func newPromoteLaunchTemplateVersionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &promoteLaunchTemplateVersionAction{}, nil
+}
+
+var (
+	_ action.Action = (*promoteLaunchTemplateVersionAction)(nil)
+)
+
+type promoteLaunchTemplateVersionAction struct {
+	framework.ActionWithModel[promoteLaunchTemplateVersionModel]
+}
+
+type promoteLaunchTemplateVersionModel struct {
+	framework.WithRegionModel
+	ExpectedDefaultVersion types.Int64  `tfsdk:"expected_default_version"`
+	LaunchTemplateID       types.String `tfsdk:"launch_template_id"`
+	Timeout                types.Int64  `tfsdk:"timeout"`
+	ValidateImage          types.Bool   `tfsdk:"validate_image"`
+	Version                types.Int64  `tfsdk:"version"`
+}
+
+func (a *promoteLaunchTemplateVersionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Promotes a launch template version to be the default version after validating it. This action will wait for the new default version to be visible.",
+		Attributes: map[string]schema.Attribute{
+			"expected_default_version": schema.Int64Attribute{
+				Description: "The version that must currently be the default. If the current default version differs, the promotion is aborted",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"launch_template_id": schema.StringAttribute{
+				Description: "The ID of the launch template",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^lt-[0-9a-f]{17}$`),
+						"must be a valid EC2 launch template ID (e.g., lt-1234567890abcdef0)",
+					),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the new default version to be visible (default: 300)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+			"validate_image": schema.BoolAttribute{
+				Description: "Whether to check that the AMI referenced by the version exists and is available before promoting it (default: true)",
+				Optional:    true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Description: "The launch template version to promote to default",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *promoteLaunchTemplateVersionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config promoteLaunchTemplateVersionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	launchTemplateID := config.LaunchTemplateID.ValueString()
+	version := config.Version.ValueInt64()
+
+	// Set defaults if not provided
+	timeout := 300 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+	validateImage := true
+	if !config.ValidateImage.IsNull() {
+		validateImage = config.ValidateImage.ValueBool()
+	}
+
+	tflog.Info(ctx, "Starting EC2 promote launch template version action", map[string]any{
+		"launch_template_id": launchTemplateID,
+		names.AttrVersion:    version,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Validating version %d of EC2 launch template %s...", version, launchTemplateID),
+	})
+
+	lt, err := findLaunchTemplateByID(ctx, conn, launchTemplateID)
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Launch Template Not Found",
+				fmt.Sprintf("EC2 launch template %s was not found", launchTemplateID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Launch Template",
+			fmt.Sprintf("Could not describe EC2 launch template %s: %s", launchTemplateID, err),
+		)
+		return
+	}
+
+	currentDefault := aws.ToInt64(lt.DefaultVersionNumber)
+
+	// Guard against promoting over an unexpected default, e.g. a concurrent promotion
+	if !config.ExpectedDefaultVersion.IsNull() && config.ExpectedDefaultVersion.ValueInt64() != currentDefault {
+		resp.Diagnostics.AddError(
+			"Unexpected Default Version",
+			fmt.Sprintf("EC2 launch template %s has default version %d, expected %d", launchTemplateID, currentDefault, config.ExpectedDefaultVersion.ValueInt64()),
+		)
+		return
+	}
+
+	ltVersion, err := findLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, strconv.FormatInt(version, 10))
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Launch Template Version Not Found",
+				fmt.Sprintf("Version %d of EC2 launch template %s was not found", version, launchTemplateID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Launch Template Version",
+			fmt.Sprintf("Could not describe version %d of EC2 launch template %s: %s", version, launchTemplateID, err),
+		)
+		return
+	}
+
+	if validateImage {
+		// Images resolved from SSM parameters at launch time can't be checked here
+		if imageID := aws.ToString(ltVersion.LaunchTemplateData.ImageId); imageID != "" && !strings.HasPrefix(imageID, "resolve:ssm:") {
+			image, err := findImageByID(ctx, conn, imageID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Image Validation Failed",
+					fmt.Sprintf("Could not find AMI %s referenced by version %d of EC2 launch template %s: %s", imageID, version, launchTemplateID, err),
+				)
+				return
+			}
+
+			if image.State != awstypes.ImageStateAvailable {
+				resp.Diagnostics.AddError(
+					"Image Validation Failed",
+					fmt.Sprintf("AMI %s referenced by version %d of EC2 launch template %s is in state '%s', expected '%s'", imageID, version, launchTemplateID, image.State, awstypes.ImageStateAvailable),
+				)
+				return
+			}
+		}
+	}
+
+	// Check if version is already the default
+	if currentDefault == version {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Version %d is already the default version of EC2 launch template %s", version, launchTemplateID),
+		})
+		tflog.Info(ctx, "Launch template version already default", map[string]any{
+			"launch_template_id": launchTemplateID,
+			names.AttrVersion:    version,
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Promoting version %d of EC2 launch template %s to default (was %d)...", version, launchTemplateID, currentDefault),
+	})
+
+	if err := modifyLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Promote Launch Template Version",
+			fmt.Sprintf("Could not set version %d as default for EC2 launch template %s: %s", version, launchTemplateID, err),
+		)
+		return
+	}
+
+	// Wait for the new default version to be visible
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		lt, derr := findLaunchTemplateByID(ctx, conn, launchTemplateID)
+		if derr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing launch template: %w", derr)
+		}
+		status := launchTemplateDefaultVersionStatusPending
+		if aws.ToInt64(lt.DefaultVersionNumber) == version {
+			status = launchTemplateDefaultVersionStatusPromoted
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(status)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(promoteLaunchTemplateVersionPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{launchTemplateDefaultVersionStatusPromoted},
+		TransitionalStates: []actionwait.Status{launchTemplateDefaultVersionStatusPending},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for version %d to become the default version of EC2 launch template %s...", version, launchTemplateID)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Launch Template Version Promotion",
+				fmt.Sprintf("Version %d of EC2 launch template %s did not become the default version within %s: %s", version, launchTemplateID, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Launch Template Version Promotion",
+				fmt.Sprintf("Error while waiting for version %d of EC2 launch template %s to become the default version: %s", version, launchTemplateID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Version %d is now the default version of EC2 launch template %s", version, launchTemplateID),
+	})
+
+	tflog.Info(ctx, "EC2 promote launch template version action completed successfully", map[string]any{
+		"launch_template_id": launchTemplateID,
+		names.AttrVersion:    version,
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2PromoteLaunchTemplateVersionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromoteLaunchTemplateVersionActionConfig_trigger(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &v),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, resourceName, 1),
+				),
+			},
+			{
+				// Creates version 2, which the action promotes.
+				Config: testAccPromoteLaunchTemplateVersionActionConfig_trigger(rName, "t3.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &v),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2PromoteLaunchTemplateVersionAction_expectedDefaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPromoteLaunchTemplateVersionActionConfig_expectedDefaultVersion(rName, 5),
+				ExpectError: regexache.MustCompile(`Unexpected Default Version`),
+			},
+		},
+	})
+}
+
+func testAccPromoteLaunchTemplateVersionActionConfig_trigger(rName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+}
+
+action "aws_ec2_promote_launch_template_version" "test" {
+  config {
+    launch_template_id = aws_launch_template.test.id
+    version            = aws_launch_template.test.latest_version
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_launch_template.test.latest_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_ec2_promote_launch_template_version.test]
+    }
+  }
+}
+`, rName, instanceType)
+}
+
+func testAccPromoteLaunchTemplateVersionActionConfig_expectedDefaultVersion(rName string, expectedDefaultVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t3.micro"
+}
+
+action "aws_ec2_promote_launch_template_version" "test" {
+  config {
+    launch_template_id       = aws_launch_template.test.id
+    version                  = aws_launch_template.test.latest_version
+    expected_default_version = %[2]d
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_launch_template.test.latest_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ec2_promote_launch_template_version.test]
+    }
+  }
+}
+`, rName, expectedDefaultVersion)
+}
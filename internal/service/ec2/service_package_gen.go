@@ -21,6 +21,12 @@ type servicePackage struct{}
 
 func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
 	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newPromoteLaunchTemplateVersionAction,
+			TypeName: "aws_ec2_promote_launch_template_version",
+			Name:     "Promote Launch Template Version",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newStopInstanceAction,
 			TypeName: "aws_ec2_stop_instance",
@@ -147,6 +153,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "EIP Domain Name",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newLaunchTemplateDefaultVersionResource,
+			TypeName: "aws_launch_template_default_version",
+			Name:     "Launch Template Default Version",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newNATGatewayEIPAssociationResource,
 			TypeName: "aws_nat_gateway_eip_association",
@@ -264,6 +276,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newSecurityGroupRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSecurityGroupVPCAssociationResource,
 			TypeName: "aws_vpc_security_group_vpc_association",
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupRulesExclusiveResource{}
+
+	return r, nil
+}
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithModel[securityGroupRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRules(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := data.GroupID.ValueString()
+	ingressRuleIDs, egressRuleIDs, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Group (%s) rules", groupID), err.Error())
+
+		return
+	}
+
+	data.EgressRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, egressRuleIDs)
+	data.IngressRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, ingressRuleIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.EgressRuleIDs.Equal(old.EgressRuleIDs) || !new.IngressRuleIDs.Equal(old.IngressRuleIDs) {
+		response.Diagnostics.Append(r.syncRules(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncRules revokes the security group rules that are not configured.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, data *securityGroupRulesExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := data.GroupID.ValueString()
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Security Group (%s) rules", groupID), err.Error())
+
+		return diags
+	}
+
+	wantIngress := fwflex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs)
+	wantEgress := fwflex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)
+
+	missingIngress, revokeIngress, _ := intflex.DiffSlices(haveIngress, wantIngress, func(s1, s2 string) bool { return s1 == s2 })
+	missingEgress, revokeEgress, _ := intflex.DiffSlices(haveEgress, wantEgress, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missingIngress) > 0 {
+		diags.AddAttributeError(path.Root("ingress_rule_ids"), "Security Group Ingress Rules Not Found",
+			fmt.Sprintf("Security Group (%s) has no ingress rules with IDs %v", groupID, missingIngress))
+	}
+	if len(missingEgress) > 0 {
+		diags.AddAttributeError(path.Root("egress_rule_ids"), "Security Group Egress Rules Not Found",
+			fmt.Sprintf("Security Group (%s) has no egress rules with IDs %v", groupID, missingEgress))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(revokeIngress) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: revokeIngress,
+		}
+		_, err := conn.RevokeSecurityGroupIngress(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("revoking Security Group (%s) ingress rules", groupID), err.Error())
+
+			return diags
+		}
+	}
+
+	if len(revokeEgress) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: revokeEgress,
+		}
+		_, err := conn.RevokeSecurityGroupEgress(ctx, &input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("revoking Security Group (%s) egress rules", groupID), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// findSecurityGroupRuleIDsBySecurityGroupID returns the IDs of the specified security group's ingress and egress rules.
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	// Ensure the security group exists, as filtering rules by a non-existent group ID returns no rules.
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleID := func(v awstypes.SecurityGroupRule) string {
+		return aws.ToString(v.SecurityGroupRuleId)
+	}
+	ingress := tfslices.ApplyToAll(tfslices.Filter(rules, func(v awstypes.SecurityGroupRule) bool {
+		return !aws.ToBool(v.IsEgress)
+	}), ruleID)
+	egress := tfslices.ApplyToAll(tfslices.Filter(rules, func(v awstypes.SecurityGroupRule) bool {
+		return aws.ToBool(v.IsEgress)
+	}), ruleID)
+
+	return ingress, egress, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	EgressRuleIDs  fwtypes.SetOfString `tfsdk:"egress_rule_ids"`
+	GroupID        types.String        `tfsdk:"security_group_id"`
+	IngressRuleIDs fwtypes.SetOfString `tfsdk:"ingress_rule_ids"`
+}
@@ -10,27 +10,28 @@ var (
 	ResourceAccountPasswordPolicy = resourceAccountPasswordPolicy
 	ResourceGroup                 = resourceGroup
 	// ResourceGroupMembership       = resourceGroupMembership
-	ResourceGroupPolicy               = resourceGroupPolicy
-	ResourceGroupPolicyAttachment     = resourceGroupPolicyAttachment
-	ResourceInstanceProfile           = resourceInstanceProfile
-	ResourceOpenIDConnectProvider     = resourceOpenIDConnectProvider
-	ResourceOrganizationsFeatures     = newOrganizationsFeaturesResource
-	ResourcePolicy                    = resourcePolicy
-	ResourcePolicyAttachment          = resourcePolicyAttachment
-	ResourceRolePolicy                = resourceRolePolicy
-	ResourceRolePolicyAttachment      = resourceRolePolicyAttachment
-	ResourceSAMLProvider              = resourceSAMLProvider
-	ResourceServerCertificate         = resourceServerCertificate
-	ResourceServiceLinkedRole         = resourceServiceLinkedRole
-	ResourceServiceSpecificCredential = resourceServiceSpecificCredential
-	ResourceSigningCertificate        = resourceSigningCertificate
-	ResourceUser                      = resourceUser
-	ResourceUserGroupMembership       = resourceUserGroupMembership
-	ResourceUserLoginProfile          = resourceUserLoginProfile
-	ResourceUserPolicy                = resourceUserPolicy
-	ResourceUserPolicyAttachment      = resourceUserPolicyAttachment
-	ResourceUserSSHKey                = resourceUserSSHKey
-	ResourceVirtualMFADevice          = resourceVirtualMFADevice
+	ResourceGroupPolicy                   = resourceGroupPolicy
+	ResourceGroupPolicyAttachment         = resourceGroupPolicyAttachment
+	ResourceInstanceProfile               = resourceInstanceProfile
+	ResourceOpenIDConnectProvider         = resourceOpenIDConnectProvider
+	ResourceOrganizationsFeatures         = newOrganizationsFeaturesResource
+	ResourceOutboundWebIdentityFederation = newOutboundWebIdentityFederationResource
+	ResourcePolicy                        = resourcePolicy
+	ResourcePolicyAttachment              = resourcePolicyAttachment
+	ResourceRolePolicy                    = resourceRolePolicy
+	ResourceRolePolicyAttachment          = resourceRolePolicyAttachment
+	ResourceSAMLProvider                  = resourceSAMLProvider
+	ResourceServerCertificate             = resourceServerCertificate
+	ResourceServiceLinkedRole             = resourceServiceLinkedRole
+	ResourceServiceSpecificCredential     = resourceServiceSpecificCredential
+	ResourceSigningCertificate            = resourceSigningCertificate
+	ResourceUser                          = resourceUser
+	ResourceUserGroupMembership           = resourceUserGroupMembership
+	ResourceUserLoginProfile              = resourceUserLoginProfile
+	ResourceUserPolicy                    = resourceUserPolicy
+	ResourceUserPolicyAttachment          = resourceUserPolicyAttachment
+	ResourceUserSSHKey                    = resourceUserSSHKey
+	ResourceVirtualMFADevice              = resourceVirtualMFADevice
 
 	FindAccessKeyByTwoPartKey                   = findAccessKeyByTwoPartKey
 	FindAccountAlias                            = findAccountAlias
@@ -49,6 +50,7 @@ var (
 	FindInstanceProfileByName                   = findInstanceProfileByName
 	FindOpenIDConnectProviderByARN              = findOpenIDConnectProviderByARN
 	FindOrganizationsFeatures                   = findOrganizationsFeatures
+	FindOutboundWebIdentityFederation           = findOutboundWebIdentityFederation
 	FindPolicyByARN                             = findPolicyByARN
 	FindRolePolicyByTwoPartKey                  = findRolePolicyByTwoPartKey
 	FindRolePoliciesByName                      = findRolePoliciesByName
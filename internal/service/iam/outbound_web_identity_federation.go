@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_iam_outbound_web_identity_federation", name="Outbound Web Identity Federation")
+func newOutboundWebIdentityFederationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &outboundWebIdentityFederationResource{}
+
+	return r, nil
+}
+
+type outboundWebIdentityFederationResource struct {
+	framework.ResourceWithModel[outboundWebIdentityFederationResourceModel]
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (r *outboundWebIdentityFederationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"issuer_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *outboundWebIdentityFederationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data outboundWebIdentityFederationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	input := iam.EnableOutboundWebIdentityFederationInput{}
+	_, err := conn.EnableOutboundWebIdentityFederation(ctx, &input)
+
+	// Enabling is idempotent from Terraform's point of view.
+	if err != nil && !errs.IsA[*awstypes.FeatureEnabledException](err) {
+		response.Diagnostics.AddError("enabling IAM Outbound Web Identity Federation", err.Error())
+
+		return
+	}
+
+	output, err := findOutboundWebIdentityFederation(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IAM Outbound Web Identity Federation", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, r.Meta().AccountID(ctx))
+	data.IssuerIdentifier = fwflex.StringToFramework(ctx, output.IssuerIdentifier)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *outboundWebIdentityFederationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data outboundWebIdentityFederationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	output, err := findOutboundWebIdentityFederation(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Outbound Web Identity Federation (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.IssuerIdentifier = fwflex.StringToFramework(ctx, output.IssuerIdentifier)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *outboundWebIdentityFederationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data outboundWebIdentityFederationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	input := iam.DisableOutboundWebIdentityFederationInput{}
+	_, err := conn.DisableOutboundWebIdentityFederation(ctx, &input)
+
+	if errs.IsA[*awstypes.FeatureDisabledException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("disabling IAM Outbound Web Identity Federation (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type outboundWebIdentityFederationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	IssuerIdentifier types.String `tfsdk:"issuer_identifier"`
+}
+
+func findOutboundWebIdentityFederation(ctx context.Context, conn *iam.Client) (*iam.GetOutboundWebIdentityFederationInfoOutput, error) {
+	input := iam.GetOutboundWebIdentityFederationInfoInput{}
+
+	output, err := conn.GetOutboundWebIdentityFederationInfo(ctx, &input)
+
+	if errs.IsA[*awstypes.FeatureDisabledException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || !output.JwtVendingEnabled {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
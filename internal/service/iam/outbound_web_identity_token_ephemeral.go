@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	webIdentityTokenSigningAlgorithmES384 = "ES384"
+	webIdentityTokenSigningAlgorithmRS256 = "RS256"
+)
+
+func webIdentityTokenSigningAlgorithm_Values() []string {
+	return []string{
+		webIdentityTokenSigningAlgorithmES384,
+		webIdentityTokenSigningAlgorithmRS256,
+	}
+}
+
+// @EphemeralResource("aws_iam_outbound_web_identity_token", name="Outbound Web Identity Token")
+func newOutboundWebIdentityTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &outboundWebIdentityTokenEphemeralResource{}, nil
+}
+
+type outboundWebIdentityTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[outboundWebIdentityTokenEphemeralResourceModel]
+}
+
+func (e *outboundWebIdentityTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"audience": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(60, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"signing_algorithm": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(webIdentityTokenSigningAlgorithm_Values()...),
+				},
+			},
+			"tags": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"web_identity_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *outboundWebIdentityTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data outboundWebIdentityTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	var input sts.GetWebIdentityTokenInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("Tags")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The token's custom claims are passed as STS session tags.
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+		input.Tags = append(input.Tags, ststypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	output, err := conn.GetWebIdentityToken(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type outboundWebIdentityTokenEphemeralResourceModel struct {
+	Audience         fwtypes.SetOfString `tfsdk:"audience"`
+	DurationSeconds  types.Int32         `tfsdk:"duration_seconds"`
+	Expiration       timetypes.RFC3339   `tfsdk:"expiration"`
+	SigningAlgorithm types.String        `tfsdk:"signing_algorithm"`
+	Tags             fwtypes.MapOfString `tfsdk:"tags"`
+	WebIdentityToken types.String        `tfsdk:"web_identity_token"`
+}
@@ -10,22 +10,29 @@ var (
 	ResourceCustomKeyStore     = resourceCustomKeyStore
 	ResourceExternalKey        = resourceExternalKey
 	ResourceGrant              = resourceGrant
+	ResourceGrantsExclusive    = newGrantsExclusiveResource
 	ResourceKey                = resourceKey
 	ResourceKeyPolicy          = resourceKeyPolicy
+	ResourceKeyPolicyStatement = newKeyPolicyStatementResource
 	ResourceReplicaExternalKey = resourceReplicaExternalKey
 	ResourceReplicaKey         = resourceReplicaKey
 
-	AliasARNToKeyARN          = aliasARNToKeyARN
-	AliasNamePrefix           = aliasNamePrefix
-	FindCustomKeyStoreByID    = findCustomKeyStoreByID
-	FindGrantByTwoPartKey     = findGrantByTwoPartKey
-	FindKeyByID               = findKeyByID
-	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
-	GrantParseResourceID      = grantParseResourceID
-	KeyARNOrIDEqual           = keyARNOrIDEqual
-	PropagationTimeout        = propagationTimeout
-	PolicyNameDefault         = policyNameDefault
-	SecretRemovedMessage      = secretRemovedMessage
+	AliasARNToKeyARN                   = aliasARNToKeyARN
+	AliasNamePrefix                    = aliasNamePrefix
+	FindCustomKeyStoreByID             = findCustomKeyStoreByID
+	FindGrantByTwoPartKey              = findGrantByTwoPartKey
+	FindGrantIDsByKeyID                = findGrantIDsByKeyID
+	FindKeyByID                        = findKeyByID
+	FindKeyPolicyByTwoPartKey          = findKeyPolicyByTwoPartKey
+	FindKeyPolicyStatementByTwoPartKey = findKeyPolicyStatementByTwoPartKey
+	GrantParseResourceID               = grantParseResourceID
+	KeyARNOrIDEqual                    = keyARNOrIDEqual
+	KeyPolicyStatementsEquivalent      = keyPolicyStatementsEquivalent
+	PropagationTimeout                 = propagationTimeout
+	PolicyNameDefault                  = policyNameDefault
+	PutKeyPolicyStatement              = putKeyPolicyStatement
+	RemoveKeyPolicyStatement           = removeKeyPolicyStatement
+	SecretRemovedMessage               = secretRemovedMessage
 
 	ValidNameForResource   = validNameForResource
 	ValidateKeyARN         = validateKeyARN
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kms_grants_exclusive", name="Grants Exclusive")
+func newGrantsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &grantsExclusiveResource{}
+
+	return r, nil
+}
+
+type grantsExclusiveResource struct {
+	framework.ResourceWithModel[grantsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *grantsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grant_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+		},
+	}
+}
+
+func (r *grantsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data grantsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncGrants(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *grantsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data grantsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID := data.KeyID.ValueString()
+	grantIDs, err := findGrantIDsByKeyID(ctx, conn, keyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading KMS Key (%s) Grants", keyID), err.Error())
+
+		return
+	}
+
+	data.GrantIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, grantIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *grantsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old grantsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.GrantIDs.Equal(old.GrantIDs) {
+		response.Diagnostics.Append(r.syncGrants(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *grantsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrKeyID), request, response)
+}
+
+// syncGrants revokes the key's grants that are not configured.
+func (r *grantsExclusiveResource) syncGrants(ctx context.Context, data *grantsExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID := data.KeyID.ValueString()
+	have, err := findGrantIDsByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading KMS Key (%s) Grants", keyID), err.Error())
+
+		return diags
+	}
+
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, data.GrantIDs)
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root("grant_ids"), "KMS Grants Not Found",
+			fmt.Sprintf("KMS Key (%s) has no grants with IDs %v", keyID, missing))
+
+		return diags
+	}
+
+	for _, grantID := range remove {
+		input := kms.RevokeGrantInput{
+			GrantId: aws.String(grantID),
+			KeyId:   aws.String(keyID),
+		}
+
+		_, err := conn.RevokeGrant(ctx, &input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("revoking KMS Key (%s) Grant (%s)", keyID, grantID), err.Error())
+
+			return diags
+		}
+
+		_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+			return findGrantByTwoPartKey(ctx, conn, keyID, grantID)
+		})
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("waiting for KMS Key (%s) Grant (%s) revoke", keyID, grantID), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func findGrantIDsByKeyID(ctx context.Context, conn *kms.Client, keyID string) ([]string, error) {
+	input := &kms.ListGrantsInput{
+		KeyId: aws.String(keyID),
+		Limit: aws.Int32(100),
+	}
+
+	output, err := findGrants(ctx, conn, input, tfslices.PredicateTrue[*awstypes.GrantListEntry]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.GrantListEntry) string {
+		return aws.ToString(v.GrantId)
+	}), nil
+}
+
+type grantsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	GrantIDs fwtypes.SetOfString `tfsdk:"grant_ids"`
+	KeyID    types.String        `tfsdk:"key_id"`
+}
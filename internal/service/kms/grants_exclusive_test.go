@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSGrantsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+	keyResourceName := "aws_kms_key.test"
+	grantResourceName := "aws_kms_grant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant_ids.*", grantResourceName, "grant_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrKeyID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrKeyID,
+			},
+		},
+	})
+}
+
+// A grant created out of band should be revoked.
+func TestAccKMSGrantsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					testAccCheckGrantsExclusiveCreateGrant(ctx, resourceName, "aws_iam_role.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSGrantsExclusive_grantNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGrantsExclusiveConfig_grantNotFound(rName),
+				ExpectError: regexp.MustCompile(`KMS Grants Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckGrantsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		keyID := rs.Primary.Attributes[names.AttrKeyID]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		grantIDs, err := tfkms.FindGrantIDsByKeyID(ctx, conn, keyID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["grant_ids.#"], strconv.Itoa(len(grantIDs)); got != want {
+			return fmt.Errorf("KMS Key (%s) grant count: got %s, want %s", keyID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGrantsExclusiveCreateGrant(ctx context.Context, n, roleResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		role, ok := s.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", roleResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		input := kms.CreateGrantInput{
+			GranteePrincipal: aws.String(role.Primary.Attributes[names.AttrARN]),
+			KeyId:            aws.String(rs.Primary.Attributes[names.AttrKeyID]),
+			Operations:       []awstypes.GrantOperation{awstypes.GrantOperationDescribeKey},
+		}
+		_, err := conn.CreateGrant(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccGrantsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_basic(rName, `"Encrypt", "Decrypt"`), `
+resource "aws_kms_grants_exclusive" "test" {
+  key_id    = aws_kms_key.test.key_id
+  grant_ids = [aws_kms_grant.test.grant_id]
+}
+`)
+}
+
+func testAccGrantsExclusiveConfig_grantNotFound(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_base(rName), `
+resource "aws_kms_grants_exclusive" "test" {
+  key_id    = aws_kms_key.test.key_id
+  grant_ids = ["0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"]
+}
+`)
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kms_key_policy_statement", name="Key Policy Statement")
+func newKeyPolicyStatementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &keyPolicyStatementResource{}
+
+	return r, nil
+}
+
+type keyPolicyStatementResource struct {
+	framework.ResourceWithModel[keyPolicyStatementResourceModel]
+}
+
+func (r *keyPolicyStatementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bypass_policy_lockout_safety_check": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			"sid": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"statement": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.JSON(),
+				},
+			},
+		},
+	}
+}
+
+func (r *keyPolicyStatementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID, sid := data.KeyID.ValueString(), data.SID.ValueString()
+	err := modifyKeyPolicy(ctx, conn, keyID, data.BypassPolicyLockoutSafetyCheck.ValueBool(), func(policy string) (string, error) {
+		if _, err := findKeyPolicyStatementBySID(policy, sid); err == nil {
+			return "", fmt.Errorf("a statement with Sid %q already exists", sid)
+		}
+
+		return putKeyPolicyStatement(policy, sid, data.Statement.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating KMS Key (%s) Policy Statement (%s)", keyID, sid), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keyPolicyStatementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID, sid := data.KeyID.ValueString(), data.SID.ValueString()
+	statement, err := findKeyPolicyStatementByTwoPartKey(ctx, conn, keyID, sid)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading KMS Key (%s) Policy Statement (%s)", keyID, sid), err.Error())
+
+		return
+	}
+
+	if !keyPolicyStatementsEquivalent(data.Statement.ValueString(), statement, sid) {
+		data.Statement = types.StringValue(statement)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keyPolicyStatementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID, sid := new.KeyID.ValueString(), new.SID.ValueString()
+	if !new.Statement.Equal(old.Statement) {
+		err := modifyKeyPolicy(ctx, conn, keyID, new.BypassPolicyLockoutSafetyCheck.ValueBool(), func(policy string) (string, error) {
+			return putKeyPolicyStatement(policy, sid, new.Statement.ValueString())
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating KMS Key (%s) Policy Statement (%s)", keyID, sid), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keyPolicyStatementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data keyPolicyStatementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID, sid := data.KeyID.ValueString(), data.SID.ValueString()
+	err := modifyKeyPolicy(ctx, conn, keyID, data.BypassPolicyLockoutSafetyCheck.ValueBool(), func(policy string) (string, error) {
+		return removeKeyPolicyStatement(policy, sid)
+	})
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting KMS Key (%s) Policy Statement (%s)", keyID, sid), err.Error())
+
+		return
+	}
+}
+
+func (r *keyPolicyStatementResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	keyID, sid, err := keyPolicyStatementParseImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrKeyID), keyID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("sid"), sid)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("bypass_policy_lockout_safety_check"), false)...)
+}
+
+// modifyKeyPolicy applies f to the key's default key policy and writes back the result.
+// The read-modify-write is serialized per key, as the key policy is shared by all of the key's
+// aws_kms_key_policy_statement resources.
+// f returns an empty policy if no change is required.
+func modifyKeyPolicy(ctx context.Context, conn *kms.Client, keyID string, bypassPolicyLockoutSafetyCheck bool, f func(string) (string, error)) error {
+	// Lock on the key's ID so that references to the same key by ID and by ARN are serialized.
+	key, err := findKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return err
+	}
+
+	mutexKey := keyPolicyMutexKey(aws.ToString(key.KeyId))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return err
+	}
+
+	newPolicy, err := f(aws.ToString(policy))
+
+	if err != nil {
+		return err
+	}
+
+	if newPolicy == "" {
+		return nil
+	}
+
+	return updateKeyPolicy(ctx, conn, "KMS Key", keyID, newPolicy, bypassPolicyLockoutSafetyCheck)
+}
+
+func keyPolicyMutexKey(keyID string) string {
+	return fmt.Sprintf("kms-key-policy-%s", keyID)
+}
+
+func findKeyPolicyStatementByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, sid string) (string, error) {
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return "", err
+	}
+
+	return findKeyPolicyStatementBySID(aws.ToString(policy), sid)
+}
+
+// decodeKeyPolicy decodes a key policy document, returning the document and its statements.
+// The policy's Statement element may be a single statement or a list of statements.
+func decodeKeyPolicy(policy string) (map[string]any, []any, error) {
+	var document map[string]any
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, nil, fmt.Errorf("decoding key policy: %w", err)
+	}
+
+	switch v := document["Statement"].(type) {
+	case []any:
+		return document, v, nil
+	case map[string]any:
+		return document, []any{v}, nil
+	default:
+		return document, nil, nil
+	}
+}
+
+func encodeKeyPolicy(document map[string]any, statements []any) (string, error) {
+	document["Statement"] = statements
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding key policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// decodeKeyPolicyStatement decodes a single key policy statement and sets its Sid.
+func decodeKeyPolicyStatement(statement, sid string) (map[string]any, error) {
+	var v map[string]any
+
+	if err := json.Unmarshal([]byte(statement), &v); err != nil {
+		return nil, fmt.Errorf("decoding key policy statement: %w", err)
+	}
+
+	if s, ok := v["Sid"].(string); ok && s != sid {
+		return nil, fmt.Errorf("key policy statement Sid (%s) does not match %s", s, sid)
+	}
+
+	v["Sid"] = sid
+
+	return v, nil
+}
+
+func keyPolicyStatementSIDEquals(sid string) func(any) bool {
+	return func(v any) bool {
+		statement, ok := v.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		s, ok := statement["Sid"].(string)
+
+		return ok && s == sid
+	}
+}
+
+// findKeyPolicyStatementBySID returns the JSON encoding of the policy statement with the specified Sid.
+func findKeyPolicyStatementBySID(policy, sid string) (string, error) {
+	_, statements, err := decodeKeyPolicy(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	i := slices.IndexFunc(statements, keyPolicyStatementSIDEquals(sid))
+	if i < 0 {
+		return "", &retry.NotFoundError{}
+	}
+
+	b, err := json.Marshal(statements[i])
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// putKeyPolicyStatement replaces the policy statement with the specified Sid, or appends it if there is none.
+// Other statements are left unchanged.
+func putKeyPolicyStatement(policy, sid, statement string) (string, error) {
+	document, statements, err := decodeKeyPolicy(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	v, err := decodeKeyPolicyStatement(statement, sid)
+
+	if err != nil {
+		return "", err
+	}
+
+	if i := slices.IndexFunc(statements, keyPolicyStatementSIDEquals(sid)); i >= 0 {
+		statements[i] = v
+	} else {
+		statements = append(statements, v)
+	}
+
+	return encodeKeyPolicy(document, statements)
+}
+
+// removeKeyPolicyStatement removes the policy statement with the specified Sid.
+// An empty policy is returned if there is no such statement.
+func removeKeyPolicyStatement(policy, sid string) (string, error) {
+	document, statements, err := decodeKeyPolicy(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	n := len(statements)
+	statements = slices.DeleteFunc(statements, keyPolicyStatementSIDEquals(sid))
+
+	if len(statements) == n {
+		return "", nil
+	}
+
+	return encodeKeyPolicy(document, statements)
+}
+
+// keyPolicyStatementsEquivalent returns whether two key policy statements are equivalent,
+// using the same policy equivalence rules as whole policy documents.
+func keyPolicyStatementsEquivalent(s1, s2, sid string) bool {
+	policy := func(statement string) string {
+		v, err := decodeKeyPolicyStatement(statement, sid)
+		if err != nil {
+			return ""
+		}
+
+		b, err := json.Marshal(map[string]any{
+			"Version":   "2012-10-17",
+			"Statement": []any{v},
+		})
+		if err != nil {
+			return ""
+		}
+
+		return string(b)
+	}
+
+	p1, p2 := policy(s1), policy(s2)
+	if p1 == "" || p2 == "" {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(p1, p2)
+}
+
+const keyPolicyStatementImportIDSeparator = ","
+
+func keyPolicyStatementParseImportID(id string) (string, string, error) {
+	parts := strings.Split(id, keyPolicyStatementImportIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected KEY_ID%[2]sSID", id, keyPolicyStatementImportIDSeparator)
+}
+
+type keyPolicyStatementResourceModel struct {
+	framework.WithRegionModel
+	BypassPolicyLockoutSafetyCheck types.Bool   `tfsdk:"bypass_policy_lockout_safety_check"`
+	KeyID                          types.String `tfsdk:"key_id"`
+	SID                            types.String `tfsdk:"sid"`
+	Statement                      types.String `tfsdk:"statement"`
+}
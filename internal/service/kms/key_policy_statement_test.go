@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPutKeyPolicyStatement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy    string
+		sid       string
+		statement string
+		expected  string
+		wantErr   bool
+	}{
+		"append": {
+			policy:    `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"}]}`,
+			sid:       "Test",
+			statement: `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"},{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}]}`,
+		},
+		"append to single statement": {
+			policy:    `{"Version":"2012-10-17","Statement":{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"}}`,
+			sid:       "Test",
+			statement: `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"},{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}]}`,
+		},
+		"replace": {
+			policy:    `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Allow","Action":"kms:Encrypt","Resource":"*"},{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"}]}`,
+			sid:       "Test",
+			statement: `{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"},{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"}]}`,
+		},
+		"Sid mismatch": {
+			policy:    `{"Version":"2012-10-17","Statement":[]}`,
+			sid:       "Test",
+			statement: `{"Sid":"Other","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			wantErr:   true,
+		},
+		"invalid policy": {
+			policy:    `{`,
+			sid:       "Test",
+			statement: `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfkms.PutKeyPolicyStatement(testCase.policy, testCase.sid, testCase.statement)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("PutKeyPolicyStatement() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil && !verify.PolicyStringsEquivalent(got, testCase.expected) {
+				t.Errorf("PutKeyPolicyStatement() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRemoveKeyPolicyStatement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		sid      string
+		expected string
+	}{
+		"remove": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"},{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}]}`,
+			sid:      "Test",
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"}]}`,
+		},
+		"not present": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Action":"kms:*","Resource":"*"}]}`,
+			sid:      "Test",
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfkms.RemoveKeyPolicyStatement(testCase.policy, testCase.sid)
+
+			if err != nil {
+				t.Fatalf("RemoveKeyPolicyStatement() err: %v", err)
+			}
+
+			if testCase.expected == "" {
+				if got != "" {
+					t.Errorf("RemoveKeyPolicyStatement() = %s, want empty", got)
+				}
+			} else if !verify.PolicyStringsEquivalent(got, testCase.expected) {
+				t.Errorf("RemoveKeyPolicyStatement() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestKeyPolicyStatementsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s1, s2   string
+		expected bool
+	}{
+		"identical": {
+			s1:       `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			s2:       `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			expected: true,
+		},
+		"implicit Sid": {
+			s1:       `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			s2:       `{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			expected: true,
+		},
+		"single action list": {
+			s1:       `{"Sid":"Test","Effect":"Allow","Action":["kms:Decrypt"],"Resource":"*"}`,
+			s2:       `{"Sid":"Test","Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			expected: true,
+		},
+		"different actions": {
+			s1:       `{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}`,
+			s2:       `{"Effect":"Allow","Action":"kms:Encrypt","Resource":"*"}`,
+			expected: false,
+		},
+		"invalid JSON": {
+			s1:       `{`,
+			s2:       `{"Effect":"Allow","Action":"kms:Encrypt","Resource":"*"}`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfkms.KeyPolicyStatementsEquivalent(testCase.s1, testCase.s2, "Test"), testCase.expected; got != want {
+				t.Errorf("KeyPolicyStatementsEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccKMSKeyPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_policy_statement.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName, "kms:Decrypt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "sid", "Test"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccKeyPolicyStatementImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "sid",
+				ImportStateVerifyIgnore:              []string{"statement"},
+			},
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName, "kms:Encrypt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sid", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_basic(rName, "kms:Decrypt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfkms.ResourceKeyPolicyStatement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// Statements managed by separate resources on the same key must not clobber each other.
+func TestAccKMSKeyPolicyStatement_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_kms_key_policy_statement.test1"
+	resourceName2 := "aws_kms_key_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyStatementConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName1),
+					testAccCheckKeyPolicyStatementExists(ctx, resourceName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kms_key_policy_statement" {
+				continue
+			}
+
+			_, err := tfkms.FindKeyPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("KMS Key Policy Statement %s still exists", rs.Primary.Attributes["sid"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		_, err := tfkms.FindKeyPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccKeyPolicyStatementImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[names.AttrKeyID] + "," + rs.Primary.Attributes["sid"], nil
+	}
+}
+
+func testAccKeyPolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
+
+func testAccKeyPolicyStatementConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyStatementConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key_policy_statement" "test" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "Test"
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = %[1]q
+    Resource = "*"
+  })
+}
+`, action))
+}
+
+func testAccKeyPolicyStatementConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccKeyPolicyStatementConfig_base(rName), `
+resource "aws_kms_key_policy_statement" "test1" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "Test1"
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = "kms:Decrypt"
+    Resource = "*"
+  })
+}
+
+resource "aws_kms_key_policy_statement" "test2" {
+  key_id = aws_kms_key.test.key_id
+  sid    = "Test2"
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = "kms:Encrypt"
+    Resource = "*"
+  })
+}
+`)
+}
@@ -15,9 +15,11 @@ var (
 	ResourceInvocation                   = resourceInvocation
 	ResourceLayerVersion                 = resourceLayerVersion
 	ResourceLayerVersionPermission       = resourceLayerVersionPermission
+	ResourcePackage                      = newPackageResource
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
+	BuildPackage                                 = buildPackage
 	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
 	FindCapacityProviderByName                   = findCapacityProviderByName
 	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
@@ -36,6 +38,7 @@ var (
 	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
 	LayerVersionParseResourceID                  = layerVersionParseResourceID
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
+	PackageSourceCodeHash                        = packageSourceCodeHash
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
 	InvocationParseResourceID                    = invocationParseResourceID
 
@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @FrameworkResource("aws_lambda_package", name="Package")
+func newPackageResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &packageResource{}, nil
+}
+
+const (
+	ResNamePackage = "Package"
+
+	// packageSourceCodeHashMetadataKey is the S3 object metadata key under which the package's source code hash is stored.
+	packageSourceCodeHashMetadataKey = "source-code-hash"
+)
+
+// packageModTime is the modification time of every file in a package.
+// Fixed timestamps and permissions make the package's content depend only on the content of its files.
+var packageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type packageResource struct {
+	framework.ResourceWithModel[packageResourceModel]
+}
+
+func (r *packageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"exclude": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"include": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"output_path": schema.StringAttribute{
+				Optional: true,
+			},
+			"output_size": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrS3Bucket: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_key": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_object_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"source_code_hash": schema.StringAttribute{
+				Computed: true,
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *packageResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("output_path"),
+			path.MatchRoot(names.AttrS3Bucket),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot(names.AttrS3Bucket),
+			path.MatchRoot("s3_key"),
+		),
+	}
+}
+
+func (r *packageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan packageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.build(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Lambda, create.ErrActionCreating, ResNamePackage, plan.SourceDir.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *packageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state packageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A missing or modified S3 object is recorded with an empty source code hash so that the next plan rebuilds it.
+	// A missing local output file is detected at plan time.
+	if bucket, key := state.S3Bucket.ValueString(), state.S3Key.ValueString(); bucket != "" {
+		conn := r.Meta().S3Client(ctx)
+
+		out, err := findPackageObject(ctx, conn, bucket, key)
+		switch {
+		case errs.IsA[*s3types.NotFound](err):
+			state.SourceCodeHash = types.StringValue("")
+		case err != nil:
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Lambda, create.ErrActionSetting, ResNamePackage, state.SourceDir.String(), err),
+				err.Error(),
+			)
+			return
+		case out.Metadata[packageSourceCodeHashMetadataKey] != state.SourceCodeHash.ValueString():
+			state.SourceCodeHash = types.StringValue("")
+		default:
+			state.S3ObjectVersion = flex.StringToFramework(ctx, out.VersionId)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *packageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan packageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.build(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Lambda, create.ErrActionUpdating, ResNamePackage, plan.SourceDir.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *packageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state packageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := state.OutputPath.ValueString(); v != "" {
+		filename, err := homedir.Expand(v)
+		if err == nil {
+			err = os.Remove(filename)
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Lambda, create.ErrActionDeleting, ResNamePackage, state.SourceDir.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if bucket, key := state.S3Bucket.ValueString(), state.S3Key.ValueString(); bucket != "" {
+		conn := r.Meta().S3Client(ctx)
+
+		in := &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		_, err := conn.DeleteObject(ctx, in)
+		if err != nil && !errs.IsA[*s3types.NoSuchBucket](err) {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Lambda, create.ErrActionDeleting, ResNamePackage, state.SourceDir.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ModifyPlan computes the source code hash from the content of the source files,
+// so that changes to the source directory show up in the plan of dependent resources.
+func (r *packageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan packageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceDir.IsUnknown() || plan.Include.IsUnknown() || plan.Exclude.IsUnknown() {
+		plan.OutputSize = types.Int64Unknown()
+		plan.S3ObjectVersion = types.StringUnknown()
+		plan.SourceCodeHash = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	hash, err := packageSourceCodeHash(plan.SourceDir.ValueString(), flex.ExpandFrameworkStringValueSet(ctx, plan.Include), flex.ExpandFrameworkStringValueSet(ctx, plan.Exclude))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_dir"), "Reading Source Directory", err.Error())
+		return
+	}
+
+	plan.SourceCodeHash = types.StringValue(hash)
+	plan.OutputSize = types.Int64Unknown()
+	plan.S3ObjectVersion = types.StringUnknown()
+
+	if !req.State.Raw.IsNull() {
+		var state packageResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.SourceCodeHash.Equal(state.SourceCodeHash) && plan.OutputPath.Equal(state.OutputPath) {
+			plan.S3ObjectVersion = state.S3ObjectVersion
+
+			// A missing output file, for example on a fresh CI runner, is rebuilt without changing the source code hash.
+			if packageOutputExists(plan.OutputPath.ValueString()) {
+				plan.OutputSize = state.OutputSize
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// build writes the package to the output path and uploads it to S3, as configured.
+// The S3 object is only replaced if its source code hash differs.
+func (r *packageResource) build(ctx context.Context, plan *packageResourceModel) error {
+	hash, body, err := buildPackage(plan.SourceDir.ValueString(), flex.ExpandFrameworkStringValueSet(ctx, plan.Include), flex.ExpandFrameworkStringValueSet(ctx, plan.Exclude))
+	if err != nil {
+		return err
+	}
+
+	plan.OutputSize = types.Int64Value(int64(len(body)))
+	plan.SourceCodeHash = types.StringValue(hash)
+
+	if v := plan.OutputPath.ValueString(); v != "" {
+		filename, err := homedir.Expand(v)
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filename, body, 0o644); err != nil {
+			return fmt.Errorf("writing package (%s): %w", filename, err)
+		}
+	}
+
+	plan.S3ObjectVersion = types.StringNull()
+
+	if bucket, key := plan.S3Bucket.ValueString(), plan.S3Key.ValueString(); bucket != "" {
+		conn := r.Meta().S3Client(ctx)
+
+		out, err := findPackageObject(ctx, conn, bucket, key)
+		if err == nil && out.Metadata[packageSourceCodeHashMetadataKey] == hash {
+			plan.S3ObjectVersion = flex.StringToFramework(ctx, out.VersionId)
+			return nil
+		}
+		if err != nil && !errs.IsA[*s3types.NotFound](err) {
+			return err
+		}
+
+		in := &s3.PutObjectInput{
+			Body:        bytes.NewReader(body),
+			Bucket:      aws.String(bucket),
+			ContentType: aws.String("application/zip"),
+			Key:         aws.String(key),
+			Metadata: map[string]string{
+				packageSourceCodeHashMetadataKey: hash,
+			},
+		}
+
+		putOut, err := conn.PutObject(ctx, in)
+		if err != nil {
+			return fmt.Errorf("uploading package to S3 Bucket (%s) Key (%s): %w", bucket, key, err)
+		}
+
+		plan.S3ObjectVersion = flex.StringToFramework(ctx, putOut.VersionId)
+	}
+
+	return nil
+}
+
+func findPackageObject(ctx context.Context, conn *s3.Client, bucket, key string) (*s3.HeadObjectOutput, error) {
+	in := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	return conn.HeadObject(ctx, in)
+}
+
+func packageOutputExists(v string) bool {
+	if v == "" {
+		return true
+	}
+
+	filename, err := homedir.Expand(v)
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(filename)
+
+	return err == nil
+}
+
+// packageFileMode returns the mode of a file in a package.
+// Only the owner's execute bit is taken from the source file, so that executables such as custom runtime bootstraps keep working.
+func packageFileMode(fi fs.FileInfo) fs.FileMode {
+	if fi.Mode()&0o100 != 0 {
+		return 0o755
+	}
+
+	return 0o644
+}
+
+// packageSourceCodeHash returns the base64-encoded SHA256 hash of the package's files,
+// computed from each file's path, mode and content.
+func packageSourceCodeHash(sourceDir string, include, exclude []string) (string, error) {
+	h := sha256.New()
+
+	err := tfio.WalkFiles(sourceDir, include, exclude, func(name, filename string, fi fs.FileInfo) error {
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+
+		writePackageSourceCodeHashEntry(h, name, packageFileMode(fi), content)
+
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildPackage returns the package's source code hash and a deterministic zip archive of the package's files.
+func buildPackage(sourceDir string, include, exclude []string) (string, []byte, error) {
+	h := sha256.New()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	err := tfio.WalkFiles(sourceDir, include, exclude, func(name, filename string, fi fs.FileInfo) error {
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+
+		mode := packageFileMode(fi)
+		writePackageSourceCodeHashEntry(h, name, mode, content)
+
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: packageModTime,
+		}
+		header.SetMode(mode)
+
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		_, err = f.Write(content)
+
+		return err
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), buf.Bytes(), nil
+}
+
+func writePackageSourceCodeHashEntry(w io.Writer, name string, mode fs.FileMode, content []byte) {
+	sum := sha256.Sum256(content)
+	fmt.Fprintf(w, "%s\x00%o\x00%s\n", name, mode, hex.EncodeToString(sum[:]))
+}
+
+type packageResourceModel struct {
+	framework.WithRegionModel
+	Exclude         fwtypes.SetOfString `tfsdk:"exclude"`
+	Include         fwtypes.SetOfString `tfsdk:"include"`
+	OutputPath      types.String        `tfsdk:"output_path"`
+	OutputSize      types.Int64         `tfsdk:"output_size"`
+	S3Bucket        types.String        `tfsdk:"s3_bucket"`
+	S3Key           types.String        `tfsdk:"s3_key"`
+	S3ObjectVersion types.String        `tfsdk:"s3_object_version"`
+	SourceCodeHash  types.String        `tfsdk:"source_code_hash"`
+	SourceDir       types.String        `tfsdk:"source_dir"`
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBuildPackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	testAccPackageWriteFiles(t, dir, map[string]string{
+		"index.js":       "exports.handler = async () => 'ok';",
+		"lib/util.js":    "module.exports = {};",
+		"test/index.js":  "// test",
+		"bootstrap":      "#!/bin/sh",
+		"node_modules/x": "x",
+	})
+	if err := os.Chmod(filepath.Join(dir, "bootstrap"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	exclude := []string{"test/**", "node_modules/**"}
+
+	hash1, body1, err := tflambda.BuildPackage(dir, nil, exclude)
+	if err != nil {
+		t.Fatalf("BuildPackage() err: %v", err)
+	}
+
+	// Modification times must not affect the package.
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), time.Now(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	hash2, body2, err := tflambda.BuildPackage(dir, nil, exclude)
+	if err != nil {
+		t.Fatalf("BuildPackage() err: %v", err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("BuildPackage() source code hash changed: %s, %s", hash1, hash2)
+	}
+
+	if !bytes.Equal(body1, body2) {
+		t.Error("BuildPackage() is not deterministic")
+	}
+
+	hash, err := tflambda.PackageSourceCodeHash(dir, nil, exclude)
+	if err != nil {
+		t.Fatalf("PackageSourceCodeHash() err: %v", err)
+	}
+
+	if hash != hash1 {
+		t.Errorf("PackageSourceCodeHash() = %s, want %s", hash, hash1)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(body1), int64(len(body1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]os.FileMode{
+		"bootstrap":   0o755,
+		"index.js":    0o644,
+		"lib/util.js": 0o644,
+	}
+
+	if got, want := len(r.File), len(want); got != want {
+		t.Fatalf("package has %d files, want %d", got, want)
+	}
+
+	for _, f := range r.File {
+		mode, ok := want[f.Name]
+		if !ok {
+			t.Errorf("unexpected file %s", f.Name)
+			continue
+		}
+
+		if got := f.Mode().Perm(); got != mode {
+			t.Errorf("file %s mode = %o, want %o", f.Name, got, mode)
+		}
+	}
+
+	testAccPackageWriteFiles(t, dir, map[string]string{
+		"index.js": "exports.handler = async () => 'changed';",
+	})
+
+	hash3, err := tflambda.PackageSourceCodeHash(dir, nil, exclude)
+	if err != nil {
+		t.Fatalf("PackageSourceCodeHash() err: %v", err)
+	}
+
+	if hash3 == hash1 {
+		t.Error("PackageSourceCodeHash() did not change with file content")
+	}
+}
+
+func TestAccLambdaPackage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_package.test"
+	functionResourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+	outputPath := filepath.Join(t.TempDir(), "package.zip")
+	testAccPackageWriteFiles(t, dir, map[string]string{
+		"index.js": "exports.handler = async () => 'ok';",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, dir, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "output_path", outputPath),
+					resource.TestCheckResourceAttrSet(resourceName, "output_size"),
+					resource.TestCheckNoResourceAttr(resourceName, "s3_object_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+					resource.TestCheckResourceAttrPair(functionResourceName, "source_code_hash", resourceName, "source_code_hash"),
+				),
+			},
+			{
+				// A missing output file is rebuilt without changing the function.
+				PreConfig: func() {
+					if err := os.Remove(outputPath); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccPackageConfig_basic(rName, dir, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(functionResourceName, "source_code_hash", resourceName, "source_code_hash"),
+					testAccCheckPackageOutputExists(outputPath),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccPackageWriteFiles(t, dir, map[string]string{
+						"index.js": "exports.handler = async () => 'changed';",
+					})
+				},
+				Config: testAccPackageConfig_basic(rName, dir, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(functionResourceName, "source_code_hash", resourceName, "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaPackage_s3(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_package.test"
+	dir := t.TempDir()
+	testAccPackageWriteFiles(t, dir, map[string]string{
+		"index.js": "exports.handler = async () => 'ok';",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_s3(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageObjectExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "output_path"),
+					resource.TestCheckResourceAttr(resourceName, "s3_key", "package.zip"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_object_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckPackageOutputExists(filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := os.Stat(filename)
+
+		return err
+	}
+}
+
+func testAccCheckPackageObjectExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes[names.AttrS3Bucket]),
+			Key:    aws.String(rs.Primary.Attributes["s3_key"]),
+		}
+		output, err := conn.HeadObject(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := output.Metadata["source-code-hash"], rs.Primary.Attributes["source_code_hash"]; got != want {
+			return fmt.Errorf("Lambda Package source code hash: got %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_package" {
+				continue
+			}
+
+			input := s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes[names.AttrS3Bucket]),
+				Key:    aws.String(rs.Primary.Attributes["s3_key"]),
+			}
+			_, err := conn.HeadObject(ctx, &input)
+
+			if err == nil {
+				return fmt.Errorf("Lambda Package %s still exists", rs.Primary.Attributes["s3_key"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageConfig_basic(rName, sourceDir, outputPath string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_package" "test" {
+  source_dir  = %[2]q
+  output_path = %[3]q
+}
+
+resource "aws_lambda_function" "test" {
+  filename         = aws_lambda_package.test.output_path
+  source_code_hash = aws_lambda_package.test.source_code_hash
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "index.handler"
+  runtime          = "nodejs20.x"
+}
+`, rName, sourceDir, outputPath))
+}
+
+func testAccPackageConfig_s3(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_lambda_package" "test" {
+  source_dir = %[2]q
+  s3_bucket  = aws_s3_bucket_versioning.test.bucket
+  s3_key     = "package.zip"
+}
+`, rName, sourceDir)
+}
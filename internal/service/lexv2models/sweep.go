@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lexv2models_bot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lexv2models_bot", &resource.Sweeper{
 		Name: "aws_lexv2models_bot",
 		F:    sweepBots,
 	})
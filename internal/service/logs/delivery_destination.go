@@ -33,6 +33,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="logs:GetDeliveryDestination;logs:PutDeliveryDestination;logs:TagResource", update="logs:PutDeliveryDestination;logs:TagResource;logs:UntagResource", delete="logs:DeleteDeliveryDestination")
 // @Testing(tagsTest=false)
 func newDeliveryDestinationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliveryDestinationResource{}
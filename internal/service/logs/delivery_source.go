@@ -31,6 +31,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="logs:GetDeliverySource;logs:PutDeliverySource;logs:TagResource", update="logs:TagResource;logs:UntagResource", delete="logs:DeleteDeliverySource")
 // @Testing(tagsTest=false)
 func newDeliverySourceResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliverySourceResource{}
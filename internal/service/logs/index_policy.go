@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource("aws_cloudwatch_log_index_policy", name="Index Policy")
+// @Permissions(create="logs:PutIndexPolicy", update="logs:PutIndexPolicy", delete="logs:DeleteIndexPolicy")
 func newIndexPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &indexPolicyResource{}
 
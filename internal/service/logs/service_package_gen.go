@@ -51,6 +51,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"logs:GetDeliveryDestination",
+					"logs:PutDeliveryDestination",
+					"logs:TagResource",
+				},
+				Update: []string{
+					"logs:PutDeliveryDestination",
+					"logs:TagResource",
+					"logs:UntagResource",
+				},
+				Delete: []string{
+					"logs:DeleteDeliveryDestination",
+				},
+			},
 		},
 		{
 			Factory:  newDeliveryDestinationPolicyResource,
@@ -66,12 +81,37 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"logs:GetDeliverySource",
+					"logs:PutDeliverySource",
+					"logs:TagResource",
+				},
+				Update: []string{
+					"logs:TagResource",
+					"logs:UntagResource",
+				},
+				Delete: []string{
+					"logs:DeleteDeliverySource",
+				},
+			},
 		},
 		{
 			Factory:  newIndexPolicyResource,
 			TypeName: "aws_cloudwatch_log_index_policy",
 			Name:     "Index Policy",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"logs:PutIndexPolicy",
+				},
+				Update: []string{
+					"logs:PutIndexPolicy",
+				},
+				Delete: []string{
+					"logs:DeleteIndexPolicy",
+				},
+			},
 		},
 		{
 			Factory:  newTransformerResource,
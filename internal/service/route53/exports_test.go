@@ -13,6 +13,7 @@ var (
 	ResourceKeySigningKey               = resourceKeySigningKey
 	ResourceQueryLog                    = resourceQueryLog
 	ResourceRecord                      = resourceRecord
+	ResourceRecordSetPolicy             = newRecordSetPolicyResource
 	ResourceTrafficPolicy               = resourceTrafficPolicy
 	ResourceTrafficPolicyInstance       = resourceTrafficPolicyInstance
 	ResourceVPCAssociationAuthorization = resourceVPCAssociationAuthorization
@@ -30,6 +31,7 @@ var (
 	FindKeySigningKeyByTwoPartKey               = findKeySigningKeyByTwoPartKey
 	FindQueryLoggingConfigByID                  = findQueryLoggingConfigByID
 	FindResourceRecordSetByFourPartKey          = findResourceRecordSetByFourPartKey
+	FindRecordSetPolicyRecordSetsByThreePartKey = findRecordSetPolicyRecordSetsByThreePartKey
 	FindResourceRecordSetsForHostedZone         = findResourceRecordSetsForHostedZone
 	FindTrafficPolicyByID                       = findTrafficPolicyByID
 	FindTrafficPolicyInstanceByID               = findTrafficPolicyInstanceByID
@@ -37,6 +39,7 @@ var (
 	FindZoneAssociationByThreePartKey           = findZoneAssociationByThreePartKey
 	KeySigningKeyStatusActive                   = keySigningKeyStatusActive
 	KeySigningKeyStatusInactive                 = keySigningKeyStatusInactive
+	RecordSetWeightsAtStep                      = recordSetWeightsAtStep
 	ServeSignatureNotSigning                    = serveSignatureNotSigning
 	ServeSignatureSigning                       = serveSignatureSigning
 	WaitChangeInsync                            = waitChangeInsync
@@ -0,0 +1,749 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route53_record_set_policy", name="Record Set Policy")
+func newRecordSetPolicyResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recordSetPolicyResource{}
+
+	r.SetDefaultCreateTimeout(45 * time.Minute)
+	r.SetDefaultUpdateTimeout(45 * time.Minute)
+	r.SetDefaultDeleteTimeout(45 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameRecordSetPolicy = "Record Set Policy"
+)
+
+type recordSetRoutingPolicy string
+
+const (
+	recordSetRoutingPolicyFailover recordSetRoutingPolicy = "failover"
+	recordSetRoutingPolicyLatency  recordSetRoutingPolicy = "latency"
+	recordSetRoutingPolicyWeighted recordSetRoutingPolicy = "weighted"
+)
+
+func (recordSetRoutingPolicy) Values() []recordSetRoutingPolicy {
+	return []recordSetRoutingPolicy{
+		recordSetRoutingPolicyFailover,
+		recordSetRoutingPolicyLatency,
+		recordSetRoutingPolicyWeighted,
+	}
+}
+
+type recordSetPolicyResource struct {
+	framework.ResourceWithModel[recordSetPolicyResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *recordSetPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"routing_policy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[recordSetRoutingPolicy](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"record": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[recordSetPolicyRecordModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"failover": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetFailover](),
+							Optional:   true,
+						},
+						"health_check_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"label": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"records": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						names.AttrRegion: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetRegion](),
+							Optional:   true,
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAlias: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recordSetPolicyAliasModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"evaluate_target_health": schema.BoolAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										CustomType: fwtypes.DNSNameStringType,
+										Required:   true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1024),
+										},
+									},
+									"zone_id": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(32),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *recordSetPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data recordSetPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoutingPolicy.IsUnknown() || data.Record.IsUnknown() || data.Record.IsNull() {
+		return
+	}
+
+	records, diags := data.Record.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy := data.RoutingPolicy.ValueEnum()
+	recordPath := path.Root("record")
+	setIDs := make(map[string]struct{}, len(records))
+	failovers := make(map[awstypes.ResourceRecordSetFailover]int)
+
+	for _, record := range records {
+		if !record.Records.IsUnknown() && !record.Alias.IsUnknown() {
+			if record.Records.IsNull() == (len(record.Alias.Elements()) == 0) {
+				resp.Diagnostics.AddAttributeError(recordPath, "Invalid Attribute Combination",
+					"Exactly one of records or alias must be specified for each record")
+			}
+		}
+
+		if !record.Records.IsNull() && data.TTL.IsNull() && !data.TTL.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Missing Attribute Configuration",
+				"ttl must be specified when any record has records")
+		}
+
+		switch policy {
+		case recordSetRoutingPolicyFailover:
+			if record.Failover.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(recordPath.AtName("failover"), path.Root("routing_policy"), string(policy)))
+			}
+			if !record.Region.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(recordPath.AtName(names.AttrRegion), path.Root("routing_policy"), string(policy)))
+			}
+			if !record.Weight.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(recordPath.AtName(names.AttrWeight), path.Root("routing_policy"), string(policy)))
+			}
+		case recordSetRoutingPolicyLatency:
+			if record.Region.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(recordPath.AtName(names.AttrRegion), path.Root("routing_policy"), string(policy)))
+			}
+			if !record.Failover.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(recordPath.AtName("failover"), path.Root("routing_policy"), string(policy)))
+			}
+			if !record.Weight.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(recordPath.AtName(names.AttrWeight), path.Root("routing_policy"), string(policy)))
+			}
+		case recordSetRoutingPolicyWeighted:
+			if record.Label.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(recordPath.AtName("label"), path.Root("routing_policy"), string(policy)))
+			}
+			if record.Weight.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(recordPath.AtName(names.AttrWeight), path.Root("routing_policy"), string(policy)))
+			}
+			if !record.Failover.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(recordPath.AtName("failover"), path.Root("routing_policy"), string(policy)))
+			}
+			if !record.Region.IsNull() {
+				resp.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(recordPath.AtName(names.AttrRegion), path.Root("routing_policy"), string(policy)))
+			}
+		}
+
+		if record.Label.IsUnknown() || record.Region.IsUnknown() || record.Failover.IsUnknown() {
+			return
+		}
+
+		if !record.Failover.IsNull() {
+			failovers[record.Failover.ValueEnum()]++
+		}
+
+		setID := recordSetPolicySetIdentifier(record.Label.ValueString(), string(record.Region.ValueEnum()), string(record.Failover.ValueEnum()))
+		if setID == "" {
+			continue
+		}
+		if _, ok := setIDs[setID]; ok {
+			resp.Diagnostics.AddAttributeError(recordPath, "Duplicate Set Identifier",
+				fmt.Sprintf("More than one record has the set identifier %q; use label to distinguish them", setID))
+		}
+		setIDs[setID] = struct{}{}
+	}
+
+	if policy == recordSetRoutingPolicyFailover && (len(records) != 2 || failovers[awstypes.ResourceRecordSetFailoverPrimary] != 1 || failovers[awstypes.ResourceRecordSetFailoverSecondary] != 1) {
+		resp.Diagnostics.AddAttributeError(recordPath, "Invalid Attribute Value",
+			"Failover routing requires exactly one PRIMARY and one SECONDARY record")
+	}
+}
+
+func (r *recordSetPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recordSetPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	id := plan.id()
+	hostedZone, err := findHostedZoneByID(ctx, conn, plan.ZoneID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	want, diags := expandRecordSetPolicyRecordSets(ctx, plan, aws.ToString(hostedZone.HostedZone.Name))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var changes []awstypes.Change
+	for _, v := range want {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionCreate,
+			ResourceRecordSet: &v,
+		})
+	}
+
+	changeID, err := changeRecordSetPolicyRecordSets(ctx, conn, plan.ZoneID.ValueString(), changes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitChangeInsync(ctx, conn, changeID, r.CreateTimeout(ctx, plan.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionWaitingForCreation, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *recordSetPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state recordSetPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	id := state.id()
+	output, err := findRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, state.ZoneID.ValueString(), state.Name.ValueString(), state.Type.ValueEnum())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionReading, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if state.RoutingPolicy.IsNull() {
+		state.RoutingPolicy = fwtypes.StringEnumValue(recordSetRoutingPolicyFor(output[0]))
+	}
+
+	resp.Diagnostics.Append(flattenRecordSetPolicyRecordSets(ctx, output, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *recordSetPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan recordSetPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	id := plan.id()
+	hostedZone, err := findHostedZoneByID(ctx, conn, plan.ZoneID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionUpdating, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	have, err := findRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, plan.ZoneID.ValueString(), plan.Name.ValueString(), plan.Type.ValueEnum())
+	if err != nil && !tfresource.NotFound(err) {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionUpdating, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	want, diags := expandRecordSetPolicyRecordSets(ctx, plan, aws.ToString(hostedZone.HostedZone.Name))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All changes, including weight changes across the group, are applied in a single
+	// change batch so that Route 53 never serves a partially updated group.
+	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, resourceRecordSetEqual, resourceRecordSetIdentifiersEqual)
+
+	var changes []awstypes.Change
+	for _, v := range remove {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionDelete,
+			ResourceRecordSet: &v,
+		})
+	}
+	for _, v := range add {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionCreate,
+			ResourceRecordSet: &v,
+		})
+	}
+	for _, v := range modify {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionUpsert,
+			ResourceRecordSet: &v,
+		})
+	}
+
+	if len(changes) > 0 {
+		changeID, err := changeRecordSetPolicyRecordSets(ctx, conn, plan.ZoneID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53, create.ErrActionUpdating, ResNameRecordSetPolicy, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := waitChangeInsync(ctx, conn, changeID, r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53, create.ErrActionWaitingForUpdate, ResNameRecordSetPolicy, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *recordSetPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state recordSetPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	id := state.id()
+	have, err := findRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, state.ZoneID.ValueString(), state.Name.ValueString(), state.Type.ValueEnum())
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionDeleting, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var changes []awstypes.Change
+	for _, v := range have {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionDelete,
+			ResourceRecordSet: &v,
+		})
+	}
+
+	changeID, err := changeRecordSetPolicyRecordSets(ctx, conn, state.ZoneID.ValueString(), changes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionDeleting, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitChangeInsync(ctx, conn, changeID, r.DeleteTimeout(ctx, state.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionWaitingForDeletion, ResNameRecordSetPolicy, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *recordSetPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := recordParseResourceID(req.ID)
+	zoneID, name, rrType, setID := parts[0], parts[1], parts[2], parts[3]
+
+	if zoneID == "" || name == "" || rrType == "" || setID != "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: ZONEID_NAME_TYPE. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrName), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrType), rrType)...)
+}
+
+// changeRecordSetPolicyRecordSets submits the changes in a single change batch
+// and returns the ID of the resulting change.
+func changeRecordSetPolicyRecordSets(ctx context.Context, conn *route53.Client, zoneID string, changes []awstypes.Change) (string, error) {
+	input := route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &awstypes.ChangeBatch{
+			Changes: changes,
+		},
+	}
+
+	output, err := conn.ChangeResourceRecordSets(ctx, &input)
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ChangeInfo == nil || output.ChangeInfo.Id == nil {
+		return "", errors.New("empty output")
+	}
+
+	return aws.ToString(output.ChangeInfo.Id), nil
+}
+
+// findRecordSetPolicyRecordSetsByThreePartKey returns the record sets with a set
+// identifier that share the specified name and type.
+func findRecordSetPolicyRecordSetsByThreePartKey(ctx context.Context, conn *route53.Client, zoneID, name string, rrType awstypes.RRType) ([]awstypes.ResourceRecordSet, error) {
+	hostedZone, err := findHostedZoneByID(ctx, conn, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordName := fqdn(expandRecordName(name, aws.ToString(hostedZone.HostedZone.Name)))
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(recordName),
+		StartRecordType: rrType,
+	}
+	filter := func(v *awstypes.ResourceRecordSet) bool {
+		return normalizeDomainName(v.Name) == normalizeDomainName(recordName) && v.Type == rrType && v.SetIdentifier != nil
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, &input, resourceRecordsFor(recordName, rrType), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	for i := range output {
+		sortResourceRecords(output[i].ResourceRecords)
+	}
+
+	return output, nil
+}
+
+// recordSetPolicySetIdentifier returns the set identifier generated for a record.
+// The label is used when set, otherwise latency records are identified by their
+// region and failover records by their role.
+func recordSetPolicySetIdentifier(label, region, failover string) string {
+	switch {
+	case label != "":
+		return label
+	case region != "":
+		return region
+	default:
+		return strings.ToLower(failover)
+	}
+}
+
+func recordSetRoutingPolicyFor(apiObject awstypes.ResourceRecordSet) recordSetRoutingPolicy {
+	switch {
+	case apiObject.Failover != "":
+		return recordSetRoutingPolicyFailover
+	case apiObject.Region != "":
+		return recordSetRoutingPolicyLatency
+	default:
+		return recordSetRoutingPolicyWeighted
+	}
+}
+
+// recordSetPolicyStringEnum returns a null value for an unset enum.
+func recordSetPolicyStringEnum[T enum.Valueser[T]](v T) fwtypes.StringEnum[T] {
+	if v == "" {
+		return fwtypes.StringEnumNull[T]()
+	}
+
+	return fwtypes.StringEnumValue(v)
+}
+
+func sortResourceRecords(apiObjects []awstypes.ResourceRecord) {
+	slices.SortFunc(apiObjects, func(a, b awstypes.ResourceRecord) int {
+		return cmp.Compare(aws.ToString(a.Value), aws.ToString(b.Value))
+	})
+}
+
+func expandRecordSetPolicyRecordSets(ctx context.Context, data recordSetPolicyResourceModel, zoneName string) ([]awstypes.ResourceRecordSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	records, d := data.Record.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	name := expandRecordName(data.Name.ValueString(), zoneName)
+	rrType := data.Type.ValueEnum()
+	apiObjects := make([]awstypes.ResourceRecordSet, 0, len(records))
+
+	for _, record := range records {
+		region, failover := record.Region.ValueEnum(), record.Failover.ValueEnum()
+		apiObject := awstypes.ResourceRecordSet{
+			Failover:      failover,
+			HealthCheckId: fwflex.StringFromFramework(ctx, record.HealthCheckID),
+			Name:          aws.String(name),
+			Region:        region,
+			SetIdentifier: aws.String(recordSetPolicySetIdentifier(record.Label.ValueString(), string(region), string(failover))),
+			Type:          rrType,
+			Weight:        fwflex.Int64FromFramework(ctx, record.Weight),
+		}
+
+		if !record.Records.IsNull() {
+			apiObject.ResourceRecords = expandResourceRecords(fwflex.ExpandFrameworkStringValueSet(ctx, record.Records), rrType)
+			apiObject.TTL = fwflex.Int64FromFramework(ctx, data.TTL)
+			sortResourceRecords(apiObject.ResourceRecords)
+		}
+
+		if alias, d := record.Alias.ToPtr(ctx); d.HasError() {
+			diags.Append(d...)
+			return nil, diags
+		} else if alias != nil {
+			apiObject.AliasTarget = &awstypes.AliasTarget{
+				DNSName:              alias.Name.ValueStringPointer(),
+				EvaluateTargetHealth: alias.EvaluateTargetHealth.ValueBool(),
+				HostedZoneId:         alias.ZoneID.ValueStringPointer(),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func flattenRecordSetPolicyRecordSets(ctx context.Context, apiObjects []awstypes.ResourceRecordSet, data *recordSetPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	records := make([]*recordSetPolicyRecordModel, 0, len(apiObjects))
+	ttl := types.Int64Null()
+
+	for _, apiObject := range apiObjects {
+		record := &recordSetPolicyRecordModel{
+			Alias:         fwtypes.NewListNestedObjectValueOfNull[recordSetPolicyAliasModel](ctx),
+			Failover:      recordSetPolicyStringEnum(apiObject.Failover),
+			HealthCheckID: fwflex.StringToFramework(ctx, apiObject.HealthCheckId),
+			Label:         types.StringNull(),
+			Records:       fwtypes.NewSetValueOfNull[types.String](ctx),
+			Region:        recordSetPolicyStringEnum(apiObject.Region),
+			Weight:        fwflex.Int64ToFramework(ctx, apiObject.Weight),
+		}
+
+		// The label is only set when the set identifier is not the generated default.
+		if setID := aws.ToString(apiObject.SetIdentifier); setID != recordSetPolicySetIdentifier("", string(apiObject.Region), string(apiObject.Failover)) {
+			record.Label = types.StringValue(setID)
+		}
+
+		if len(apiObject.ResourceRecords) > 0 {
+			record.Records = fwflex.FlattenFrameworkStringValueSetOfString(ctx, flattenResourceRecords(apiObject.ResourceRecords, apiObject.Type))
+			ttl = fwflex.Int64ToFramework(ctx, apiObject.TTL)
+		}
+
+		if apiObject.AliasTarget != nil {
+			record.Alias = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &recordSetPolicyAliasModel{
+				EvaluateTargetHealth: types.BoolValue(apiObject.AliasTarget.EvaluateTargetHealth),
+				Name:                 fwtypes.DNSNameStringValue(aws.ToString(apiObject.AliasTarget.DNSName)),
+				ZoneID:               fwflex.StringToFramework(ctx, apiObject.AliasTarget.HostedZoneId),
+			})
+		}
+
+		records = append(records, record)
+	}
+
+	record, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, records, nil)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Record = record
+	data.TTL = ttl
+
+	return diags
+}
+
+type recordSetPolicyResourceModel struct {
+	Name          types.String                                               `tfsdk:"name"`
+	Record        fwtypes.SetNestedObjectValueOf[recordSetPolicyRecordModel] `tfsdk:"record"`
+	RoutingPolicy fwtypes.StringEnum[recordSetRoutingPolicy]                 `tfsdk:"routing_policy"`
+	Timeouts      timeouts.Value                                             `tfsdk:"timeouts"`
+	TTL           types.Int64                                                `tfsdk:"ttl"`
+	Type          fwtypes.StringEnum[awstypes.RRType]                        `tfsdk:"type"`
+	ZoneID        types.String                                               `tfsdk:"zone_id"`
+}
+
+func (m recordSetPolicyResourceModel) id() string {
+	return strings.Join([]string{m.ZoneID.ValueString(), m.Name.ValueString(), m.Type.ValueString()}, "_")
+}
+
+type recordSetPolicyRecordModel struct {
+	Alias         fwtypes.ListNestedObjectValueOf[recordSetPolicyAliasModel] `tfsdk:"alias"`
+	Failover      fwtypes.StringEnum[awstypes.ResourceRecordSetFailover]     `tfsdk:"failover"`
+	HealthCheckID types.String                                               `tfsdk:"health_check_id"`
+	Label         types.String                                               `tfsdk:"label"`
+	Records       fwtypes.SetOfString                                        `tfsdk:"records"`
+	Region        fwtypes.StringEnum[awstypes.ResourceRecordSetRegion]       `tfsdk:"region"`
+	Weight        types.Int64                                                `tfsdk:"weight"`
+}
+
+type recordSetPolicyAliasModel struct {
+	EvaluateTargetHealth types.Bool            `tfsdk:"evaluate_target_health"`
+	Name                 fwtypes.DNSNameString `tfsdk:"name"`
+	ZoneID               types.String          `tfsdk:"zone_id"`
+}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordSetPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_record_set_policy.test"
+	zoneResourceName := "aws_route53_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordSetPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordSetPolicyConfig_weighted(zoneName.String(), 100, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordSetPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", zoneResourceName, "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "www"),
+					resource.TestCheckResourceAttr(resourceName, "routing_policy", "weighted"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"label":          "blue",
+						names.AttrWeight: "100",
+						"records.#":      "1",
+						"records.0":      "127.0.0.1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"label":          "green",
+						names.AttrWeight: "0",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordSetPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+			{
+				Config: testAccRecordSetPolicyConfig_weighted(zoneName.String(), 10, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordSetPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"label":          "blue",
+						names.AttrWeight: "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"label":          "green",
+						names.AttrWeight: "90",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordSetPolicy_disappears_Zone(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_record_set_policy.test"
+	zoneResourceName := "aws_route53_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordSetPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordSetPolicyConfig_weighted(zoneName.String(), 100, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordSetPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceZone(), zoneResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordSetPolicy_latency(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_record_set_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordSetPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordSetPolicyConfig_latency(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordSetPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_policy", "latency"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrRegion: acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrRegion: acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordSetPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordSetPolicy_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_record_set_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordSetPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordSetPolicyConfig_failover(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordSetPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_policy", "failover"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"failover": "PRIMARY",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"failover": "SECONDARY",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordSetPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_record_set_policy" {
+				continue
+			}
+
+			zoneID := rs.Primary.Attributes["zone_id"]
+			_, err := tfroute53.FindRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, zoneID, rs.Primary.Attributes[names.AttrName], types.RRType(rs.Primary.Attributes[names.AttrType]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.Route53, create.ErrActionCheckingDestroyed, tfroute53.ResNameRecordSetPolicy, zoneID, err)
+			}
+
+			return create.Error(names.Route53, create.ErrActionCheckingDestroyed, tfroute53.ResNameRecordSetPolicy, zoneID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordSetPolicyExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordSetPolicy, name, errors.New("not found"))
+		}
+
+		zoneID := rs.Primary.Attributes["zone_id"]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+		out, err := tfroute53.FindRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, zoneID, rs.Primary.Attributes[names.AttrName], types.RRType(rs.Primary.Attributes[names.AttrType]))
+		if err != nil {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordSetPolicy, zoneID, err)
+		}
+
+		if got, want := strconv.Itoa(len(out)), rs.Primary.Attributes["record.#"]; got != want {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecordSetPolicy, zoneID, fmt.Errorf("unexpected record count: got %s, want %s", got, want))
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordSetPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s_%s_%s", rs.Primary.Attributes["zone_id"], rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrType]), nil
+	}
+}
+
+func testAccRecordSetPolicyConfig_weighted(zoneName string, blueWeight, greenWeight int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record_set_policy" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  routing_policy = "weighted"
+  ttl            = 30
+
+  record {
+    label   = "blue"
+    weight  = %[2]d
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    label   = "green"
+    weight  = %[3]d
+    records = ["127.0.0.2"]
+  }
+}
+`, zoneName, blueWeight, greenWeight)
+}
+
+func testAccRecordSetPolicyConfig_latency(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record_set_policy" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "api"
+  type           = "A"
+  routing_policy = "latency"
+  ttl            = 60
+
+  record {
+    region  = %[2]q
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    region  = %[3]q
+    records = ["127.0.0.2"]
+  }
+}
+`, zoneName, acctest.Region(), acctest.AlternateRegion())
+}
+
+func testAccRecordSetPolicyConfig_failover(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_health_check" "test" {
+  fqdn              = "primary.%[1]s"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "2"
+  request_interval  = "30"
+}
+
+resource "aws_route53_record_set_policy" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "app"
+  type           = "A"
+  routing_policy = "failover"
+  ttl            = 60
+
+  record {
+    failover        = "PRIMARY"
+    health_check_id = aws_route53_health_check.test.id
+    records         = ["127.0.0.1"]
+  }
+
+  record {
+    failover = "SECONDARY"
+    records  = ["127.0.0.2"]
+  }
+}
+`, zoneName)
+}
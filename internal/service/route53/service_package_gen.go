@@ -18,6 +18,17 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newShiftRecordSetWeightsAction,
+			TypeName: "aws_route53_shift_record_set_weights",
+			Name:     "Shift Record Set Weights",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -49,6 +60,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "CIDR Location",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  newRecordSetPolicyResource,
+			TypeName: "aws_route53_record_set_policy",
+			Name:     "Record Set Policy",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  newRecordsExclusiveResource,
 			TypeName: "aws_route53_records_exclusive",
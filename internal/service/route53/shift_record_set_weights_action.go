@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// shiftRecordSetWeightsPollInterval defines polling cadence for change status.
+// Route53 is vulnerable to throttling so a longer poll interval helps to avoid it.
+const shiftRecordSetWeightsPollInterval = 15 * time.Second
+
+// @Action(aws_route53_shift_record_set_weights, name="Shift Record Set Weights")
+func newShiftRecordSetWeightsAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &shiftRecordSetWeightsAction{}, nil
+}
+
+var (
+	_ action.Action = (*shiftRecordSetWeightsAction)(nil)
+)
+
+type shiftRecordSetWeightsAction struct {
+	framework.ActionWithModel[shiftRecordSetWeightsModel]
+}
+
+type shiftRecordSetWeightsModel struct {
+	Interval types.Int64  `tfsdk:"interval"`
+	Name     types.String `tfsdk:"name"`
+	Steps    types.Int64  `tfsdk:"steps"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+	Type     types.String `tfsdk:"type"`
+	Weights  types.Map    `tfsdk:"weights"`
+	ZoneID   types.String `tfsdk:"zone_id"`
+}
+
+func (a *shiftRecordSetWeightsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gradually shifts the weights of a group of weighted Route 53 records to target values. Each step updates all weights in a single change batch and waits for it to propagate.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInterval: schema.Int64Attribute{
+				Description: "Time in seconds to wait between steps (default: 60)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtMost(3600),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Description: "The name of the weighted records",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"steps": schema.Int64Attribute{
+				Description: "Number of steps in which to shift the weights (default: 5)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for each step to propagate (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(3600),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				Description: "The record type of the weighted records",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.RRType]()...),
+				},
+			},
+			"weights": schema.MapAttribute{
+				Description: "Map of set identifier to target weight",
+				Required:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueInt64sAre(int64validator.Between(0, 255)),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The ID of the hosted zone containing the records",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *shiftRecordSetWeightsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config shiftRecordSetWeightsModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().Route53Client(ctx)
+
+	zoneID := config.ZoneID.ValueString()
+	name := config.Name.ValueString()
+	rrType := awstypes.RRType(config.Type.ValueString())
+
+	var target map[string]int64
+	resp.Diagnostics.Append(config.Weights.ElementsAs(ctx, &target, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set defaults if not provided
+	steps := int64(5)
+	if !config.Steps.IsNull() {
+		steps = config.Steps.ValueInt64()
+	}
+	interval := 60 * time.Second
+	if !config.Interval.IsNull() {
+		interval = time.Duration(config.Interval.ValueInt64()) * time.Second
+	}
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Route 53 shift record set weights action", map[string]any{
+		"zone_id":          zoneID,
+		names.AttrName:     name,
+		names.AttrType:     rrType,
+		"weights":          target,
+		"steps":            steps,
+		names.AttrInterval: interval.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting weight shift for Route 53 records %s (%s) in hosted zone %s...", name, rrType, zoneID),
+	})
+
+	recordSets, err := findRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, zoneID, name, rrType)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Records Not Found",
+			fmt.Sprintf("No weighted Route 53 records %s (%s) were found in hosted zone %s", name, rrType, zoneID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Records",
+			fmt.Sprintf("Could not list Route 53 records %s (%s) in hosted zone %s: %s", name, rrType, zoneID, err),
+		)
+		return
+	}
+
+	current := make(map[string]int64, len(recordSets))
+	for _, v := range recordSets {
+		if v.Weight != nil {
+			current[aws.ToString(v.SetIdentifier)] = aws.ToInt64(v.Weight)
+		}
+	}
+
+	for _, setID := range slices.Sorted(maps.Keys(target)) {
+		if _, ok := current[setID]; !ok {
+			resp.Diagnostics.AddError(
+				"Weighted Record Not Found",
+				fmt.Sprintf("Route 53 records %s (%s) have no weighted record with set identifier %q", name, rrType, setID),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from := maps.Clone(current)
+	for step := int64(1); step <= steps; step++ {
+		weights := recordSetWeightsAtStep(from, target, step, steps)
+
+		var changes []awstypes.Change
+		for _, v := range recordSets {
+			setID := aws.ToString(v.SetIdentifier)
+			weight, ok := weights[setID]
+			if !ok || weight == current[setID] {
+				continue
+			}
+
+			v.Weight = aws.Int64(weight)
+			changes = append(changes, awstypes.Change{
+				Action:            awstypes.ChangeActionUpsert,
+				ResourceRecordSet: &v,
+			})
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Step %d/%d: updating weights to %v...", step, steps, weights),
+		})
+
+		changeID, err := changeRecordSetPolicyRecordSets(ctx, conn, zoneID, changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Change Records",
+				fmt.Sprintf("Could not update weights of Route 53 records %s (%s) in hosted zone %s: %s", name, rrType, zoneID, err),
+			)
+			return
+		}
+
+		_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+			output, gerr := findChangeByID(ctx, conn, changeID)
+			if gerr != nil {
+				return actionwait.FetchResult[struct{}]{}, fmt.Errorf("getting change status: %w", gerr)
+			}
+			return actionwait.FetchResult[struct{}]{Status: actionwait.Status(output.Status)}, nil
+		}, actionwait.Options[struct{}]{
+			Timeout:          timeout,
+			Interval:         actionwait.FixedInterval(shiftRecordSetWeightsPollInterval),
+			ProgressInterval: 60 * time.Second,
+			SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.ChangeStatusInsync)},
+			TransitionalStates: []actionwait.Status{
+				actionwait.Status(awstypes.ChangeStatusPending),
+			},
+			ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+				resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Change %s is currently '%s', continuing to wait for '%s'...", changeID, fr.Status, awstypes.ChangeStatusInsync)})
+			},
+		})
+		if err != nil {
+			var timeoutErr *actionwait.TimeoutError
+			var unexpectedErr *actionwait.UnexpectedStateError
+			if errors.As(err, &timeoutErr) {
+				resp.Diagnostics.AddError(
+					"Timeout Waiting for Change to Propagate",
+					fmt.Sprintf("Route 53 change %s did not propagate within %s: %s", changeID, timeout, err),
+				)
+			} else if errors.As(err, &unexpectedErr) {
+				resp.Diagnostics.AddError(
+					"Unexpected Change State",
+					fmt.Sprintf("Route 53 change %s entered unexpected state: %s", changeID, err),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error Waiting for Change to Propagate",
+					fmt.Sprintf("Error while waiting for Route 53 change %s to propagate: %s", changeID, err),
+				)
+			}
+			return
+		}
+
+		maps.Copy(current, weights)
+
+		if step < steps && interval > 0 {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Step %d/%d complete, waiting %s before the next step...", step, steps, interval),
+			})
+
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError(
+					"Weight Shift Interrupted",
+					fmt.Sprintf("Weight shift for Route 53 records %s (%s) was interrupted after step %d/%d: %s", name, rrType, step, steps, ctx.Err()),
+				)
+				return
+			case <-time.After(interval):
+			}
+		}
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Weights of Route 53 records %s (%s) have been successfully shifted to %v", name, rrType, target),
+	})
+
+	tflog.Info(ctx, "Route 53 shift record set weights action completed successfully", map[string]any{
+		"zone_id":      zoneID,
+		names.AttrName: name,
+		names.AttrType: rrType,
+	})
+}
+
+// recordSetWeightsAtStep returns the weights for the specified step of a linear
+// shift from the starting weights to the target weights.
+// The final step always returns the target weights.
+func recordSetWeightsAtStep(from, to map[string]int64, step, steps int64) map[string]int64 {
+	weights := make(map[string]int64, len(to))
+
+	for setID, weight := range to {
+		weights[setID] = from[setID] + (weight-from[setID])*step/steps
+	}
+
+	return weights
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRecordSetWeightsAtStep(t *testing.T) {
+	t.Parallel()
+
+	from := map[string]int64{
+		"blue":  100,
+		"green": 0,
+	}
+	to := map[string]int64{
+		"blue":  0,
+		"green": 100,
+	}
+
+	testCases := []struct {
+		step     int64
+		steps    int64
+		expected map[string]int64
+	}{
+		{
+			step:     1,
+			steps:    1,
+			expected: map[string]int64{"blue": 0, "green": 100},
+		},
+		{
+			step:     1,
+			steps:    4,
+			expected: map[string]int64{"blue": 75, "green": 25},
+		},
+		{
+			step:     2,
+			steps:    3,
+			expected: map[string]int64{"blue": 34, "green": 66},
+		},
+		{
+			step:     3,
+			steps:    3,
+			expected: map[string]int64{"blue": 0, "green": 100},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d/%d", testCase.step, testCase.steps), func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfroute53.RecordSetWeightsAtStep(from, to, testCase.step, testCase.steps); !maps.Equal(got, testCase.expected) {
+				t.Errorf("RecordSetWeightsAtStep() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccRoute53ShiftRecordSetWeightsAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_record_set_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckRecordSetPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftRecordSetWeightsActionConfig_basic(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordSetPolicyExists(ctx, resourceName),
+					testAccCheckRecordSetWeights(ctx, resourceName, map[string]int64{
+						"blue":  0,
+						"green": 100,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordSetWeights(ctx context.Context, n string, expected map[string]int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		output, err := tfroute53.FindRecordSetPolicyRecordSetsByThreePartKey(ctx, conn, rs.Primary.Attributes["zone_id"], rs.Primary.Attributes[names.AttrName], types.RRType(rs.Primary.Attributes[names.AttrType]))
+		if err != nil {
+			return err
+		}
+
+		weights := make(map[string]int64, len(output))
+		for _, v := range output {
+			weights[aws.ToString(v.SetIdentifier)] = aws.ToInt64(v.Weight)
+		}
+
+		if !maps.Equal(weights, expected) {
+			return fmt.Errorf("Route 53 record weights: got %v, want %v", weights, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccShiftRecordSetWeightsActionConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record_set_policy" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  routing_policy = "weighted"
+  ttl            = 30
+
+  record {
+    label   = "blue"
+    weight  = 100
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    label   = "green"
+    weight  = 0
+    records = ["127.0.0.2"]
+  }
+
+  lifecycle {
+    ignore_changes = [record]
+  }
+}
+
+action "aws_route53_shift_record_set_weights" "test" {
+  config {
+    zone_id  = aws_route53_record_set_policy.test.zone_id
+    name     = aws_route53_record_set_policy.test.name
+    type     = aws_route53_record_set_policy.test.type
+    steps    = 2
+    interval = 0
+
+    weights = {
+      blue  = 0
+      green = 100
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_route53_record_set_policy.test.zone_id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_route53_shift_record_set_weights.test]
+    }
+  }
+}
+`, zoneName)
+}
@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_cors_rule", name="Bucket CORS Rule")
+func newBucketCORSRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketCORSRuleResource{}
+
+	return r, nil
+}
+
+type bucketCORSRuleResource struct {
+	framework.ResourceWithModel[bucketCORSRuleResourceModel]
+}
+
+func (r *bucketCORSRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allowed_headers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"allowed_methods": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("DELETE", "GET", "HEAD", "POST", "PUT")),
+				},
+			},
+			"allowed_origins": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"expose_headers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"max_age_seconds": schema.Int32Attribute{
+				Optional: true,
+			},
+			"rule_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketCORSRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketCORSRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationCORS)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	rules, err := findBucketCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	if slices.ContainsFunc(rules, corsRuleIDEquals(ruleID)) {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), "a CORS rule with this ID already exists")
+
+		return
+	}
+
+	rules = append(rules, data.expandCORSRule(ctx))
+
+	if err := putBucketCORSRules(ctx, conn, bucket, expectedBucketOwner, rules); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+		return findBucketCORSRuleByThreePartKey(ctx, conn, bucket, expectedBucketOwner, ruleID)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) CORS Rule (%s) create", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bucketCORSRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketCORSRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	rule, err := findBucketCORSRuleByThreePartKey(ctx, conn, bucket, expectedBucketOwner, ruleID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	data.flattenCORSRule(ctx, rule)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketCORSRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data bucketCORSRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationCORS)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	rules, err := findBucketCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	rules = slices.DeleteFunc(rules, corsRuleIDEquals(ruleID))
+	rules = append(rules, data.expandCORSRule(ctx))
+
+	if err := putBucketCORSRules(ctx, conn, bucket, expectedBucketOwner, rules); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketCORSRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketCORSRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationCORS)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	rules, err := findBucketCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	if !slices.ContainsFunc(rules, corsRuleIDEquals(ruleID)) {
+		return
+	}
+
+	err = putBucketCORSRules(ctx, conn, bucket, expectedBucketOwner, slices.DeleteFunc(rules, corsRuleIDEquals(ruleID)))
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) CORS Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+}
+
+func (r *bucketCORSRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, ruleID, err := parseBucketRuleImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("rule_id"), ruleID)...)
+}
+
+// findBucketCORSRules returns the bucket's CORS rules.
+// A bucket without a CORS configuration has no rules.
+func findBucketCORSRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]awstypes.CORSRule, error) {
+	rules, err := findCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchCORSConfiguration) || errors.Is(err, tfresource.ErrEmptyResult) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func findBucketCORSRuleByThreePartKey(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner, ruleID string) (*awstypes.CORSRule, error) {
+	rules, err := findCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(rules, corsRuleIDEquals(ruleID))
+	if i < 0 {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &rules[i], nil
+}
+
+// putBucketCORSRules replaces the bucket's CORS rules.
+// The bucket's CORS configuration is deleted if there are no rules.
+func putBucketCORSRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, rules []awstypes.CORSRule) error {
+	if len(rules) == 0 {
+		input := s3.DeleteBucketCorsInput{
+			Bucket: aws.String(bucket),
+		}
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		_, err := conn.DeleteBucketCors(ctx, &input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchCORSConfiguration) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+			return findCORSRules(ctx, conn, bucket, expectedBucketOwner)
+		})
+
+		if err != nil {
+			return fmt.Errorf("waiting for CORS configuration delete: %w", err)
+		}
+
+		return nil
+	}
+
+	input := s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &awstypes.CORSConfiguration{
+			CORSRules: rules,
+		},
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.PutBucketCors(ctx, &input)
+	}, errCodeNoSuchBucket)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "CORSConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	return err
+}
+
+func corsRuleIDEquals(id string) func(awstypes.CORSRule) bool {
+	return func(v awstypes.CORSRule) bool {
+		return aws.ToString(v.ID) == id
+	}
+}
+
+type bucketCORSRuleResourceModel struct {
+	framework.WithRegionModel
+	AllowedHeaders      fwtypes.SetOfString `tfsdk:"allowed_headers"`
+	AllowedMethods      fwtypes.SetOfString `tfsdk:"allowed_methods"`
+	AllowedOrigins      fwtypes.SetOfString `tfsdk:"allowed_origins"`
+	Bucket              types.String        `tfsdk:"bucket"`
+	ExpectedBucketOwner types.String        `tfsdk:"expected_bucket_owner"`
+	ExposeHeaders       fwtypes.SetOfString `tfsdk:"expose_headers"`
+	MaxAgeSeconds       types.Int32         `tfsdk:"max_age_seconds"`
+	RuleID              types.String        `tfsdk:"rule_id"`
+}
+
+func (m bucketCORSRuleResourceModel) expandCORSRule(ctx context.Context) awstypes.CORSRule {
+	return awstypes.CORSRule{
+		AllowedHeaders: fwflex.ExpandFrameworkStringValueSet(ctx, m.AllowedHeaders),
+		AllowedMethods: fwflex.ExpandFrameworkStringValueSet(ctx, m.AllowedMethods),
+		AllowedOrigins: fwflex.ExpandFrameworkStringValueSet(ctx, m.AllowedOrigins),
+		ExposeHeaders:  fwflex.ExpandFrameworkStringValueSet(ctx, m.ExposeHeaders),
+		ID:             fwflex.StringFromFramework(ctx, m.RuleID),
+		MaxAgeSeconds:  fwflex.Int32FromFramework(ctx, m.MaxAgeSeconds),
+	}
+}
+
+func (m *bucketCORSRuleResourceModel) flattenCORSRule(ctx context.Context, apiObject *awstypes.CORSRule) {
+	m.AllowedHeaders = fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.AllowedHeaders)
+	m.AllowedMethods = fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.AllowedMethods)
+	m.AllowedOrigins = fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.AllowedOrigins)
+	m.ExposeHeaders = fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.ExposeHeaders)
+	m.MaxAgeSeconds = fwflex.Int32ToFramework(ctx, apiObject.MaxAgeSeconds)
+	m.RuleID = fwflex.StringToFramework(ctx, apiObject.ID)
+}
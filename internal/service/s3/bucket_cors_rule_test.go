@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketCORSRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CORSRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_cors_rule.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSRuleConfig_basic(rName, "https://www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRuleExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "allowed_headers"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_methods.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_methods.*", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckNoResourceAttr(resourceName, "expose_headers"),
+					resource.TestCheckResourceAttr(resourceName, "max_age_seconds", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "rule_id", rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccBucketRuleImportStateIdFunc(resourceName, "rule_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "rule_id",
+			},
+			{
+				Config: testAccBucketCORSRuleConfig_basic(rName, "https://www.example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://www.example.org"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketCORSRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CORSRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_cors_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSRuleConfig_basic(rName, "https://www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRuleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketCORSRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketCORSRule_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.CORSRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_cors_rule.test1"
+	resourceName2 := "aws_s3_bucket_cors_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSRuleConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRuleExists(ctx, resourceName1, &v1),
+					testAccCheckBucketCORSRuleExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "expose_headers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketCORSRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_cors_rule" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketCORSRuleByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner], rs.Primary.Attributes["rule_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket CORS Rule %s still exists", rs.Primary.Attributes["rule_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketCORSRuleExists(ctx context.Context, n string, v *awstypes.CORSRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketCORSRuleByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner], rs.Primary.Attributes["rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBucketCORSRuleConfig_basic(rName, allowedOrigin string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_cors_rule" "test" {
+  bucket          = aws_s3_bucket.test.bucket
+  rule_id         = %[1]q
+  allowed_methods = ["GET"]
+  allowed_origins = [%[2]q]
+  max_age_seconds = 3000
+}
+`, rName, allowedOrigin)
+}
+
+func testAccBucketCORSRuleConfig_multiple(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_cors_rule" "test1" {
+  bucket          = aws_s3_bucket.test.bucket
+  rule_id         = "%[1]s-1"
+  allowed_headers = ["*"]
+  allowed_methods = ["PUT", "POST"]
+  allowed_origins = ["https://www.example.com"]
+}
+
+resource "aws_s3_bucket_cors_rule" "test2" {
+  bucket          = aws_s3_bucket.test.bucket
+  rule_id         = "%[1]s-2"
+  allowed_methods = ["GET"]
+  allowed_origins = ["*"]
+  expose_headers  = ["ETag"]
+}
+`, rName)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_cors_rules_exclusive", name="Bucket CORS Rules Exclusive")
+func newBucketCORSRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketCORSRulesExclusiveResource{}
+
+	return r, nil
+}
+
+type bucketCORSRulesExclusiveResource struct {
+	framework.ResourceWithModel[bucketCORSRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *bucketCORSRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketCORSRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketCORSRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRules(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bucketCORSRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketCORSRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	rules, err := findBucketCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) CORS Rules", bucket), err.Error())
+
+		return
+	}
+
+	data.RuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, tfslices.ApplyToAll(rules, func(v awstypes.CORSRule) string {
+		return aws.ToString(v.ID)
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketCORSRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bucketCORSRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.RuleIDs.Equal(old.RuleIDs) {
+		response.Diagnostics.Append(r.syncRules(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bucketCORSRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, err := parseResourceID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+}
+
+// syncRules removes the CORS rules that are not configured from the bucket's CORS configuration.
+func (r *bucketCORSRulesExclusiveResource) syncRules(ctx context.Context, data *bucketCORSRulesExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationCORS)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	rules, err := findBucketCORSRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Bucket (%s) CORS Rules", bucket), err.Error())
+
+		return diags
+	}
+
+	have := tfslices.ApplyToAll(rules, func(v awstypes.CORSRule) string {
+		return aws.ToString(v.ID)
+	})
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, data.RuleIDs)
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root("rule_ids"), "S3 Bucket CORS Rules Not Found",
+			fmt.Sprintf("S3 Bucket (%s) CORS configuration has no rules with IDs %v", bucket, missing))
+
+		return diags
+	}
+
+	if len(remove) == 0 {
+		return diags
+	}
+
+	rules = slices.DeleteFunc(rules, func(v awstypes.CORSRule) bool {
+		return slices.Contains(remove, aws.ToString(v.ID))
+	})
+
+	if err := putBucketCORSRules(ctx, conn, bucket, expectedBucketOwner, rules); err != nil {
+		diags.AddError(fmt.Sprintf("removing S3 Bucket (%s) CORS Rules", bucket), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+type bucketCORSRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	Bucket              types.String        `tfsdk:"bucket"`
+	ExpectedBucketOwner types.String        `tfsdk:"expected_bucket_owner"`
+	RuleIDs             fwtypes.SetOfString `tfsdk:"rule_ids"`
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketCORSRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_cors_rules_exclusive.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	ruleResourceName1 := "aws_s3_bucket_cors_rule.test1"
+	ruleResourceName2 := "aws_s3_bucket_cors_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_ids.*", ruleResourceName1, "rule_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_ids.*", ruleResourceName2, "rule_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrBucket),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrBucket,
+			},
+		},
+	})
+}
+
+// A rule added out of band should be removed.
+func TestAccS3BucketCORSRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_cors_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRulesExclusiveExists(ctx, resourceName),
+					testAccCheckBucketCORSRulesExclusiveAddRule(ctx, resourceName, rName+"-oob"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketCORSRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketCORSRulesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		rules, err := tfs3.FindCORSRules(ctx, conn, bucket, rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["rule_ids.#"], strconv.Itoa(len(rules)); got != want {
+			return fmt.Errorf("S3 Bucket (%s) CORS rule count: got %s, want %s", bucket, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketCORSRulesExclusiveAddRule(ctx context.Context, n, ruleID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		rules, err := tfs3.FindCORSRules(ctx, conn, bucket, "")
+
+		if err != nil {
+			return err
+		}
+
+		input := s3.PutBucketCorsInput{
+			Bucket: aws.String(bucket),
+			CORSConfiguration: &awstypes.CORSConfiguration{
+				CORSRules: append(rules, awstypes.CORSRule{
+					AllowedMethods: []string{"HEAD"},
+					AllowedOrigins: []string{"https://www.example.net"},
+					ID:             aws.String(ruleID),
+				}),
+			},
+		}
+		_, err = conn.PutBucketCors(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccBucketCORSRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketCORSRuleConfig_multiple(rName), `
+resource "aws_s3_bucket_cors_rules_exclusive" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  rule_ids = [
+    aws_s3_bucket_cors_rule.test1.rule_id,
+    aws_s3_bucket_cors_rule.test2.rule_id,
+  ]
+}
+`)
+}
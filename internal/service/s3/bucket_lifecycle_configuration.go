@@ -132,246 +132,252 @@ func (r *bucketLifecycleConfigurationResource) Schema(ctx context.Context, reque
 							},
 						},
 					},
-					Blocks: map[string]schema.Block{
-						"abort_incomplete_multipart_upload": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[abortIncompleteMultipartUploadModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"days_after_initiation": schema.Int32Attribute{
-										Optional: true,
-									},
-								},
-							},
+					Blocks: lifecycleRuleBlocks(ctx),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// lifecycleRuleBlocks returns the blocks shared by a lifecycle rule's schema in the
+// aws_s3_bucket_lifecycle_configuration and aws_s3_bucket_lifecycle_rule resources.
+func lifecycleRuleBlocks(ctx context.Context) map[string]schema.Block {
+	return map[string]schema.Block{
+		"abort_incomplete_multipart_upload": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[abortIncompleteMultipartUploadModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"days_after_initiation": schema.Int32Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"expiration": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[lifecycleExpirationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"date": schema.StringAttribute{
+						CustomType: timetypes.RFC3339Type{},
+						Optional:   true,
+					},
+					"days": schema.Int32Attribute{
+						Optional: true,
+						Computed: true, // Because of Legacy value handling
+						PlanModifiers: []planmodifier.Int32{
+							tfint32planmodifier.LegacyValue(),
 						},
-						"expiration": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[lifecycleExpirationModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"date": schema.StringAttribute{
-										CustomType: timetypes.RFC3339Type{},
-										Optional:   true,
-									},
-									"days": schema.Int32Attribute{
-										Optional: true,
-										Computed: true, // Because of Legacy value handling
-										PlanModifiers: []planmodifier.Int32{
-											tfint32planmodifier.LegacyValue(),
-										},
-									},
-									"expired_object_delete_marker": schema.BoolAttribute{
-										Optional: true,
-										Computed: true, // Because of Legacy value handling
-										PlanModifiers: []planmodifier.Bool{
-											tfboolplanmodifier.LegacyValue(),
-										},
-									},
-								},
-								Validators: []validator.Object{
-									tfobjectvalidator.WarnExactlyOneOfChildren(
-										path.MatchRelative().AtName("date"),
-										path.MatchRelative().AtName("days"),
-										path.MatchRelative().AtName("expired_object_delete_marker"),
-									),
-								},
-							},
+					},
+					"expired_object_delete_marker": schema.BoolAttribute{
+						Optional: true,
+						Computed: true, // Because of Legacy value handling
+						PlanModifiers: []planmodifier.Bool{
+							tfboolplanmodifier.LegacyValue(),
 						},
-						names.AttrFilter: schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[lifecycleRuleFilterModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Validators: []validator.Object{
-									tfobjectvalidator.WarnAtMostOneOfChildren(
-										path.MatchRelative().AtName("object_size_greater_than"),
-										path.MatchRelative().AtName("object_size_less_than"),
-										path.MatchRelative().AtName(names.AttrPrefix),
-										path.MatchRelative().AtName("and"),
-										path.MatchRelative().AtName("tag"),
-									),
-								},
-								PlanModifiers: []planmodifier.Object{
-									emptyFilterPlanModifier(),
-								},
-								Attributes: map[string]schema.Attribute{
-									"object_size_greater_than": schema.Int64Attribute{
-										Optional: true,
-										Computed: true, // Because of Legacy value handling
-										PlanModifiers: []planmodifier.Int64{
-											tfint64planmodifier.NullValue(),
-										},
-									},
-									"object_size_less_than": schema.Int64Attribute{
-										Optional: true,
-										Computed: true, // Because of Legacy value handling
-										PlanModifiers: []planmodifier.Int64{
-											tfint64planmodifier.NullValue(),
-										},
+					},
+				},
+				Validators: []validator.Object{
+					tfobjectvalidator.WarnExactlyOneOfChildren(
+						path.MatchRelative().AtName("date"),
+						path.MatchRelative().AtName("days"),
+						path.MatchRelative().AtName("expired_object_delete_marker"),
+					),
+				},
+			},
+		},
+		names.AttrFilter: schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[lifecycleRuleFilterModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Validators: []validator.Object{
+					tfobjectvalidator.WarnAtMostOneOfChildren(
+						path.MatchRelative().AtName("object_size_greater_than"),
+						path.MatchRelative().AtName("object_size_less_than"),
+						path.MatchRelative().AtName(names.AttrPrefix),
+						path.MatchRelative().AtName("and"),
+						path.MatchRelative().AtName("tag"),
+					),
+				},
+				PlanModifiers: []planmodifier.Object{
+					emptyFilterPlanModifier(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"object_size_greater_than": schema.Int64Attribute{
+						Optional: true,
+						Computed: true, // Because of Legacy value handling
+						PlanModifiers: []planmodifier.Int64{
+							tfint64planmodifier.NullValue(),
+						},
+					},
+					"object_size_less_than": schema.Int64Attribute{
+						Optional: true,
+						Computed: true, // Because of Legacy value handling
+						PlanModifiers: []planmodifier.Int64{
+							tfint64planmodifier.NullValue(),
+						},
+					},
+					names.AttrPrefix: schema.StringAttribute{
+						Optional: true,
+						Computed: true, // Because of Legacy value handling
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"and": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[lifecycleRuleAndOperatorModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"object_size_greater_than": schema.Int64Attribute{
+									Optional: true,
+									Computed: true, // Because of Legacy value handling
+									PlanModifiers: []planmodifier.Int64{
+										tfint64planmodifier.LegacyValue(),
 									},
-									names.AttrPrefix: schema.StringAttribute{
-										Optional: true,
-										Computed: true, // Because of Legacy value handling
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
 									},
 								},
-								Blocks: map[string]schema.Block{
-									"and": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[lifecycleRuleAndOperatorModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
-										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"object_size_greater_than": schema.Int64Attribute{
-													Optional: true,
-													Computed: true, // Because of Legacy value handling
-													PlanModifiers: []planmodifier.Int64{
-														tfint64planmodifier.LegacyValue(),
-													},
-													Validators: []validator.Int64{
-														int64validator.AtLeast(0),
-													},
-												},
-												"object_size_less_than": schema.Int64Attribute{
-													Optional: true,
-													Computed: true, // Because of Legacy value handling
-													PlanModifiers: []planmodifier.Int64{
-														tfint64planmodifier.LegacyValue(),
-													},
-													Validators: []validator.Int64{
-														int64validator.AtLeast(1),
-													},
-												},
-												names.AttrPrefix: schema.StringAttribute{
-													Optional: true,
-													Computed: true, // Because of Legacy value handling
-													PlanModifiers: []planmodifier.String{
-														tfstringplanmodifier.LegacyValue(),
-													},
-												},
-												names.AttrTags: schema.MapAttribute{
-													ElementType: types.StringType,
-													Optional:    true,
-													Validators: []validator.Map{
-														mapvalidator.SizeAtLeast(1),
-													},
-												},
-											},
-										},
+								"object_size_less_than": schema.Int64Attribute{
+									Optional: true,
+									Computed: true, // Because of Legacy value handling
+									PlanModifiers: []planmodifier.Int64{
+										tfint64planmodifier.LegacyValue(),
 									},
-									"tag": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[tagModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
-										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												names.AttrKey: schema.StringAttribute{
-													Required: true,
-												},
-												names.AttrValue: schema.StringAttribute{
-													Required: true,
-												},
-											},
-										},
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
 									},
 								},
-							},
-						},
-						"noncurrent_version_expiration": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[noncurrentVersionExpirationModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"newer_noncurrent_versions": schema.Int32Attribute{
-										Optional: true,
-										Validators: []validator.Int32{
-											int32validator.AtLeast(1),
-										},
-									},
-									"noncurrent_days": schema.Int32Attribute{
-										Required: true,
-										PlanModifiers: []planmodifier.Int32{
-											int32planmodifier.UseStateForUnknown(),
-										},
-										Validators: []validator.Int32{
-											int32validator.AtLeast(1),
-										},
+								names.AttrPrefix: schema.StringAttribute{
+									Optional: true,
+									Computed: true, // Because of Legacy value handling
+									PlanModifiers: []planmodifier.String{
+										tfstringplanmodifier.LegacyValue(),
 									},
 								},
-							},
-						},
-						"noncurrent_version_transition": schema.SetNestedBlock{
-							CustomType: fwtypes.NewSetNestedObjectTypeOf[noncurrentVersionTransitionModel](ctx),
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"newer_noncurrent_versions": schema.Int32Attribute{
-										Optional: true,
-										Validators: []validator.Int32{
-											int32validator.AtLeast(1),
-										},
-									},
-									"noncurrent_days": schema.Int32Attribute{
-										Required: true,
-										PlanModifiers: []planmodifier.Int32{
-											int32planmodifier.UseStateForUnknown(),
-										},
-										Validators: []validator.Int32{
-											int32validator.AtLeast(0),
-										},
-									},
-									names.AttrStorageClass: schema.StringAttribute{
-										CustomType: fwtypes.StringEnumType[awstypes.TransitionStorageClass](),
-										Required:   true,
+								names.AttrTags: schema.MapAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+									Validators: []validator.Map{
+										mapvalidator.SizeAtLeast(1),
 									},
 								},
 							},
 						},
-						"transition": schema.SetNestedBlock{
-							CustomType: fwtypes.NewSetNestedObjectTypeOf[transitionModel](ctx),
-							NestedObject: schema.NestedBlockObject{
-								PlanModifiers: []planmodifier.Object{
-									ruleTransitionForUnknownDays(),
-								},
-								Validators: []validator.Object{
-									ruleTransitionExactlyOneOfChildren(),
+					},
+					"tag": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[tagModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								names.AttrKey: schema.StringAttribute{
+									Required: true,
 								},
-								Attributes: map[string]schema.Attribute{
-									"date": schema.StringAttribute{
-										CustomType: timetypes.RFC3339Type{},
-										Optional:   true,
-									},
-									"days": schema.Int32Attribute{
-										Optional: true,
-										Computed: true, // Because of Legacy value handling
-										PlanModifiers: []planmodifier.Int32{
-											int32planmodifier.UseStateForUnknown(),
-										},
-										Validators: []validator.Int32{
-											int32validator.AtLeast(0),
-										},
-									},
-									names.AttrStorageClass: schema.StringAttribute{
-										CustomType: fwtypes.StringEnumType[awstypes.TransitionStorageClass](),
-										Required:   true,
-									},
+								names.AttrValue: schema.StringAttribute{
+									Required: true,
 								},
 							},
 						},
 					},
 				},
 			},
-			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
-				Create: true,
-				Update: true,
-			}),
+		},
+		"noncurrent_version_expiration": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[noncurrentVersionExpirationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"newer_noncurrent_versions": schema.Int32Attribute{
+						Optional: true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"noncurrent_days": schema.Int32Attribute{
+						Required: true,
+						PlanModifiers: []planmodifier.Int32{
+							int32planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"noncurrent_version_transition": schema.SetNestedBlock{
+			CustomType: fwtypes.NewSetNestedObjectTypeOf[noncurrentVersionTransitionModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"newer_noncurrent_versions": schema.Int32Attribute{
+						Optional: true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"noncurrent_days": schema.Int32Attribute{
+						Required: true,
+						PlanModifiers: []planmodifier.Int32{
+							int32planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					names.AttrStorageClass: schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.TransitionStorageClass](),
+						Required:   true,
+					},
+				},
+			},
+		},
+		"transition": schema.SetNestedBlock{
+			CustomType: fwtypes.NewSetNestedObjectTypeOf[transitionModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				PlanModifiers: []planmodifier.Object{
+					ruleTransitionForUnknownDays(),
+				},
+				Validators: []validator.Object{
+					ruleTransitionExactlyOneOfChildren(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"date": schema.StringAttribute{
+						CustomType: timetypes.RFC3339Type{},
+						Optional:   true,
+					},
+					"days": schema.Int32Attribute{
+						Optional: true,
+						Computed: true, // Because of Legacy value handling
+						PlanModifiers: []planmodifier.Int32{
+							int32planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					names.AttrStorageClass: schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.TransitionStorageClass](),
+						Required:   true,
+					},
+				},
+			},
 		},
 	}
 }
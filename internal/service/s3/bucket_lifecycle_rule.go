@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_lifecycle_rule", name="Bucket Lifecycle Rule")
+func newBucketLifecycleRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketLifecycleRuleResource{}
+
+	r.SetDefaultCreateTimeout(3 * time.Minute)
+	r.SetDefaultUpdateTimeout(3 * time.Minute)
+	r.SetDefaultDeleteTimeout(3 * time.Minute)
+
+	return r, nil
+}
+
+type bucketLifecycleRuleResource struct {
+	framework.ResourceWithModel[bucketLifecycleRuleResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *bucketLifecycleRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	blocks := lifecycleRuleBlocks(ctx)
+	blocks[names.AttrTimeouts] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"rule_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExpirationStatus](),
+				Required:   true,
+			},
+		},
+		Blocks: blocks,
+	}
+}
+
+func (r *bucketLifecycleRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketLifecycleRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	rule, diags := data.expandLifecycleRule(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationLifecycle)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	if slices.ContainsFunc(output.Rules, lifecycleRuleIDEquals(ruleID)) {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), "a lifecycle rule with this ID already exists")
+
+		return
+	}
+
+	rules := append(output.Rules, rule)
+
+	output, err = putBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner, output.TransitionDefaultMinimumObjectSize, rules, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	if i := slices.IndexFunc(output.Rules, lifecycleRuleIDEquals(ruleID)); i >= 0 {
+		response.Diagnostics.Append(data.flattenLifecycleRule(ctx, output.Rules[i])...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bucketLifecycleRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketLifecycleRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	rule, err := findBucketLifecycleRuleByThreePartKey(ctx, conn, bucket, expectedBucketOwner, ruleID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenLifecycleRule(ctx, *rule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketLifecycleRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data bucketLifecycleRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	rule, diags := data.expandLifecycleRule(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationLifecycle)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	rules := slices.DeleteFunc(output.Rules, lifecycleRuleIDEquals(ruleID))
+	rules = append(rules, rule)
+
+	output, err = putBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner, output.TransitionDefaultMinimumObjectSize, rules, r.UpdateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	if i := slices.IndexFunc(output.Rules, lifecycleRuleIDEquals(ruleID)); i >= 0 {
+		response.Diagnostics.Append(data.flattenLifecycleRule(ctx, output.Rules[i])...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketLifecycleRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketLifecycleRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, ruleID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.RuleID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationLifecycle)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+
+	if !slices.ContainsFunc(output.Rules, lifecycleRuleIDEquals(ruleID)) {
+		return
+	}
+
+	rules := slices.DeleteFunc(output.Rules, lifecycleRuleIDEquals(ruleID))
+
+	if _, err := putBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner, output.TransitionDefaultMinimumObjectSize, rules, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Lifecycle Rule (%s)", bucket, ruleID), err.Error())
+
+		return
+	}
+}
+
+func (r *bucketLifecycleRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, ruleID, err := parseBucketRuleImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("rule_id"), ruleID)...)
+}
+
+// findBucketLifecycleRules returns the bucket's lifecycle configuration.
+// A bucket without a lifecycle configuration has no rules.
+func findBucketLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchLifecycleConfiguration) || errors.Is(err, tfresource.ErrEmptyResult) {
+		return &s3.GetBucketLifecycleConfigurationOutput{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findBucketLifecycleRuleByThreePartKey(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner, ruleID string) (*awstypes.LifecycleRule, error) {
+	output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(output.Rules, lifecycleRuleIDEquals(ruleID))
+	if i < 0 {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &output.Rules[i], nil
+}
+
+// putBucketLifecycleRules replaces the bucket's lifecycle rules and waits for the change to propagate.
+// The bucket's lifecycle configuration is deleted if there are no rules.
+func putBucketLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, transitionMinSize awstypes.TransitionDefaultMinimumObjectSize, rules []awstypes.LifecycleRule, timeout time.Duration) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	if len(rules) == 0 {
+		input := s3.DeleteBucketLifecycleInput{
+			Bucket: aws.String(bucket),
+		}
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+
+		_, err := conn.DeleteBucketLifecycle(ctx, &input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchLifecycleConfiguration) {
+			return &s3.GetBucketLifecycleConfigurationOutput{}, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tfresource.RetryUntilNotFound(ctx, timeout, func(ctx context.Context) (any, error) {
+			return findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("waiting for lifecycle configuration delete: %w", err)
+		}
+
+		return &s3.GetBucketLifecycleConfigurationOutput{}, nil
+	}
+
+	input := s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &awstypes.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+		TransitionDefaultMinimumObjectSize: transitionMinSize,
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.PutBucketLifecycleConfiguration(ctx, &input)
+	}, errCodeNoSuchBucket)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := waitLifecycleConfigEquals(ctx, conn, bucket, expectedBucketOwner, transitionMinSize, rules, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for lifecycle configuration update: %w", err)
+	}
+
+	return output, nil
+}
+
+func lifecycleRuleIDEquals(id string) func(awstypes.LifecycleRule) bool {
+	return func(v awstypes.LifecycleRule) bool {
+		return aws.ToString(v.ID) == id
+	}
+}
+
+type bucketLifecycleRuleResourceModel struct {
+	framework.WithRegionModel
+	AbortIncompleteMultipartUpload fwtypes.ListNestedObjectValueOf[abortIncompleteMultipartUploadModel] `tfsdk:"abort_incomplete_multipart_upload"`
+	Bucket                         types.String                                                         `tfsdk:"bucket"`
+	ExpectedBucketOwner            types.String                                                         `tfsdk:"expected_bucket_owner"`
+	Expiration                     fwtypes.ListNestedObjectValueOf[lifecycleExpirationModel]            `tfsdk:"expiration"`
+	Filter                         fwtypes.ListNestedObjectValueOf[lifecycleRuleFilterModel]            `tfsdk:"filter"`
+	NoncurrentVersionExpirations   fwtypes.ListNestedObjectValueOf[noncurrentVersionExpirationModel]    `tfsdk:"noncurrent_version_expiration"`
+	NoncurrentVersionTransitions   fwtypes.SetNestedObjectValueOf[noncurrentVersionTransitionModel]     `tfsdk:"noncurrent_version_transition"`
+	RuleID                         types.String                                                         `tfsdk:"rule_id"`
+	Status                         fwtypes.StringEnum[awstypes.ExpirationStatus]                        `tfsdk:"status"`
+	Timeouts                       timeouts.Value                                                       `tfsdk:"timeouts"`
+	Transitions                    fwtypes.SetNestedObjectValueOf[transitionModel]                      `tfsdk:"transition"`
+}
+
+// expandLifecycleRule expands the resource model using the aws_s3_bucket_lifecycle_configuration rule model,
+// so that both resources send identical rules to the API.
+func (m bucketLifecycleRuleResourceModel) expandLifecycleRule(ctx context.Context) (awstypes.LifecycleRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rule := lifecycleRuleModel{
+		AbortIncompleteMultipartUpload: m.AbortIncompleteMultipartUpload,
+		Expiration:                     m.Expiration,
+		Filter:                         m.Filter,
+		ID:                             m.RuleID,
+		NoncurrentVersionExpirations:   m.NoncurrentVersionExpirations,
+		NoncurrentVersionTransitions:   m.NoncurrentVersionTransitions,
+		Prefix:                         types.StringNull(),
+		Status:                         m.Status,
+		Transitions:                    m.Transitions,
+	}
+
+	v, d := rule.Expand(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return awstypes.LifecycleRule{}, diags
+	}
+
+	return *v.(*awstypes.LifecycleRule), diags
+}
+
+func (m *bucketLifecycleRuleResourceModel) flattenLifecycleRule(ctx context.Context, apiObject awstypes.LifecycleRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var rule lifecycleRuleModel
+	diags.Append(rule.Flatten(ctx, apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.AbortIncompleteMultipartUpload = rule.AbortIncompleteMultipartUpload
+	m.Expiration = rule.Expiration
+	m.Filter = rule.Filter
+	m.NoncurrentVersionExpirations = rule.NoncurrentVersionExpirations
+	m.NoncurrentVersionTransitions = rule.NoncurrentVersionTransitions
+	m.RuleID = rule.ID
+	m.Status = rule.Status
+	m.Transitions = rule.Transitions
+
+	return diags
+}
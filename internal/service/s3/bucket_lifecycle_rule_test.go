@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketLifecycleRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LifecycleRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_rule.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRuleConfig_basic(rName, "Enabled", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "expiration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule_id", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBucketRuleImportStateIdFunc(resourceName, "rule_id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrTimeouts,
+				},
+				ImportStateVerifyIdentifierAttribute: "rule_id",
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LifecycleRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRuleConfig_basic(rName, "Enabled", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRuleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketLifecycleRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LifecycleRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRuleConfig_basic(rName, "Enabled", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Enabled"),
+				),
+			},
+			{
+				Config: testAccBucketLifecycleRuleConfig_basic(rName, "Disabled", 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "expiration.0.days", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleRule_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.LifecycleRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_lifecycle_rule.test1"
+	resourceName2 := "aws_s3_bucket_lifecycle_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRuleConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRuleExists(ctx, resourceName1, &v1),
+					testAccCheckBucketLifecycleRuleExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "transition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "abort_incomplete_multipart_upload.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketLifecycleRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_lifecycle_rule" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketLifecycleRuleByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner], rs.Primary.Attributes["rule_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Lifecycle Rule %s still exists", rs.Primary.Attributes["rule_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketLifecycleRuleExists(ctx context.Context, n string, v *awstypes.LifecycleRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketLifecycleRuleByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner], rs.Primary.Attributes["rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccBucketRuleImportStateIdFunc returns the BUCKET,RULE_ID import ID of a per-rule resource.
+func testAccBucketRuleImportStateIdFunc(n, attr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[attr]), nil
+	}
+}
+
+func testAccBucketLifecycleRuleConfig_basic(rName, status string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_rule" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  rule_id = %[1]q
+  status  = %[2]q
+
+  expiration {
+    days = %[3]d
+  }
+
+  filter {
+    prefix = "logs/"
+  }
+}
+`, rName, status, days)
+}
+
+func testAccBucketLifecycleRuleConfig_multiple(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_rule" "test1" {
+  bucket  = aws_s3_bucket.test.bucket
+  rule_id = "%[1]s-1"
+  status  = "Enabled"
+
+  filter {
+    prefix = "archive/"
+  }
+
+  transition {
+    days          = 30
+    storage_class = "STANDARD_IA"
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_rule" "test2" {
+  bucket  = aws_s3_bucket.test.bucket
+  rule_id = "%[1]s-2"
+  status  = "Enabled"
+
+  abort_incomplete_multipart_upload {
+    days_after_initiation = 7
+  }
+
+  filter {}
+}
+`, rName)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_lifecycle_rules_exclusive", name="Bucket Lifecycle Rules Exclusive")
+func newBucketLifecycleRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketLifecycleRulesExclusiveResource{}
+
+	r.SetDefaultCreateTimeout(3 * time.Minute)
+	r.SetDefaultUpdateTimeout(3 * time.Minute)
+
+	return r, nil
+}
+
+type bucketLifecycleRulesExclusiveResource struct {
+	framework.ResourceWithModel[bucketLifecycleRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *bucketLifecycleRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *bucketLifecycleRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketLifecycleRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRules(ctx, &data, r.CreateTimeout(ctx, data.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bucketLifecycleRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketLifecycleRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	output, err := findBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Lifecycle Rules", bucket), err.Error())
+
+		return
+	}
+
+	data.RuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, tfslices.ApplyToAll(output.Rules, func(v awstypes.LifecycleRule) string {
+		return aws.ToString(v.ID)
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketLifecycleRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bucketLifecycleRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.RuleIDs.Equal(old.RuleIDs) {
+		response.Diagnostics.Append(r.syncRules(ctx, &new, r.UpdateTimeout(ctx, new.Timeouts))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bucketLifecycleRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, err := parseResourceID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+}
+
+// syncRules removes the rules that are not configured from the bucket's lifecycle configuration.
+func (r *bucketLifecycleRulesExclusiveResource) syncRules(ctx context.Context, data *bucketLifecycleRulesExclusiveResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationLifecycle)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Bucket (%s) Lifecycle Rules", bucket), err.Error())
+
+		return diags
+	}
+
+	have := tfslices.ApplyToAll(output.Rules, func(v awstypes.LifecycleRule) string {
+		return aws.ToString(v.ID)
+	})
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, data.RuleIDs)
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root("rule_ids"), "S3 Bucket Lifecycle Rules Not Found",
+			fmt.Sprintf("S3 Bucket (%s) lifecycle configuration has no rules with IDs %v", bucket, missing))
+
+		return diags
+	}
+
+	if len(remove) == 0 {
+		return diags
+	}
+
+	rules := slices.DeleteFunc(output.Rules, func(v awstypes.LifecycleRule) bool {
+		return slices.Contains(remove, aws.ToString(v.ID))
+	})
+
+	if _, err := putBucketLifecycleRules(ctx, conn, bucket, expectedBucketOwner, output.TransitionDefaultMinimumObjectSize, rules, timeout); err != nil {
+		diags.AddError(fmt.Sprintf("removing S3 Bucket (%s) Lifecycle Rules", bucket), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+type bucketLifecycleRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	Bucket              types.String        `tfsdk:"bucket"`
+	ExpectedBucketOwner types.String        `tfsdk:"expected_bucket_owner"`
+	RuleIDs             fwtypes.SetOfString `tfsdk:"rule_ids"`
+	Timeouts            timeouts.Value      `tfsdk:"timeouts"`
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketLifecycleRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_rules_exclusive.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	ruleResourceName1 := "aws_s3_bucket_lifecycle_rule.test1"
+	ruleResourceName2 := "aws_s3_bucket_lifecycle_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_ids.*", ruleResourceName1, "rule_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_ids.*", ruleResourceName2, "rule_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrBucket),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrTimeouts,
+				},
+				ImportStateVerifyIdentifierAttribute: names.AttrBucket,
+			},
+		},
+	})
+}
+
+// A rule added out of band should be removed.
+func TestAccS3BucketLifecycleRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_rules_exclusive.test"
+	ruleResourceName1 := "aws_s3_bucket_lifecycle_rule.test1"
+	ruleResourceName2 := "aws_s3_bucket_lifecycle_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRulesExclusiveExists(ctx, resourceName),
+					testAccCheckBucketLifecycleRulesExclusiveAddRule(ctx, resourceName, rName+"-oob"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketLifecycleRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_ids.*", ruleResourceName1, "rule_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule_ids.*", ruleResourceName2, "rule_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleRulesExclusive_ruleNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleRulesExclusiveConfig_ruleNotFound(rName),
+				ExpectError: regexache.MustCompile(`S3 Bucket Lifecycle Rules Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketLifecycleRulesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketLifecycleConfiguration(ctx, conn, bucket, rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["rule_ids.#"], strconv.Itoa(len(output.Rules)); got != want {
+			return fmt.Errorf("S3 Bucket (%s) lifecycle rule count: got %s, want %s", bucket, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketLifecycleRulesExclusiveAddRule(ctx context.Context, n, ruleID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketLifecycleConfiguration(ctx, conn, bucket, "")
+
+		if err != nil {
+			return err
+		}
+
+		input := s3.PutBucketLifecycleConfigurationInput{
+			Bucket: aws.String(bucket),
+			LifecycleConfiguration: &awstypes.BucketLifecycleConfiguration{
+				Rules: append(output.Rules, awstypes.LifecycleRule{
+					Expiration: &awstypes.LifecycleExpiration{
+						Days: aws.Int32(90),
+					},
+					Filter: &awstypes.LifecycleRuleFilter{
+						Prefix: aws.String("tmp/"),
+					},
+					ID:     aws.String(ruleID),
+					Status: awstypes.ExpirationStatusEnabled,
+				}),
+			},
+		}
+		_, err = conn.PutBucketLifecycleConfiguration(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccBucketLifecycleRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketLifecycleRuleConfig_multiple(rName), `
+resource "aws_s3_bucket_lifecycle_rules_exclusive" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  rule_ids = [
+    aws_s3_bucket_lifecycle_rule.test1.rule_id,
+    aws_s3_bucket_lifecycle_rule.test2.rule_id,
+  ]
+}
+`)
+}
+
+func testAccBucketLifecycleRulesExclusiveConfig_ruleNotFound(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_rules_exclusive" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  rule_ids = ["does-not-exist"]
+}
+`, rName)
+}
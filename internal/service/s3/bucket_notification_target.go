@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_notification_target", name="Bucket Notification Target")
+func newBucketNotificationTargetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketNotificationTargetResource{}
+
+	return r, nil
+}
+
+type bucketNotificationTargetResource struct {
+	framework.ResourceWithModel[bucketNotificationTargetResourceModel]
+}
+
+func (r *bucketNotificationTargetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"events": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(enum.Values[awstypes.Event]()...)),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"filter_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"filter_suffix": schema.StringAttribute{
+				Optional: true,
+			},
+			"lambda_function_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"queue_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"target_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTopicARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (r *bucketNotificationTargetResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("lambda_function_arn"),
+			path.MatchRoot("queue_arn"),
+			path.MatchRoot(names.AttrTopicARN),
+		),
+	}
+}
+
+func (r *bucketNotificationTargetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketNotificationTargetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, targetID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.TargetID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationNotification)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	notificationConfiguration, err := findBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+
+	if slices.Contains(notificationTargetIDs(notificationConfiguration), targetID) {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Notification Target (%s)", bucket, targetID), "a notification target with this ID already exists")
+
+		return
+	}
+
+	data.addTo(ctx, notificationConfiguration)
+
+	if err := putBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner, notificationConfiguration); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+		return findBucketNotificationTargetByThreePartKey(ctx, conn, bucket, expectedBucketOwner, targetID)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) Notification Target (%s) create", bucket, targetID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *bucketNotificationTargetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketNotificationTargetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, targetID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.TargetID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	target, err := findBucketNotificationTargetByThreePartKey(ctx, conn, bucket, expectedBucketOwner, targetID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+
+	data.Events = target.Events
+	data.FilterPrefix = target.FilterPrefix
+	data.FilterSuffix = target.FilterSuffix
+	data.LambdaFunctionARN = target.LambdaFunctionARN
+	data.QueueARN = target.QueueARN
+	data.TopicARN = target.TopicARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketNotificationTargetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data bucketNotificationTargetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, targetID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.TargetID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationNotification)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	notificationConfiguration, err := findBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+
+	removeNotificationTargets(notificationConfiguration, targetID)
+	data.addTo(ctx, notificationConfiguration)
+
+	if err := putBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner, notificationConfiguration); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketNotificationTargetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketNotificationTargetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	bucket, expectedBucketOwner, targetID := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString(), data.TargetID.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	mutexKey := bucketConfigurationMutexKey(bucket, bucketConfigurationNotification)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	notificationConfiguration, err := findBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+
+	if !removeNotificationTargets(notificationConfiguration, targetID) {
+		return
+	}
+
+	err = putBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner, notificationConfiguration)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Notification Target (%s)", bucket, targetID), err.Error())
+
+		return
+	}
+}
+
+func (r *bucketNotificationTargetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, targetID, err := parseBucketRuleImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("target_id"), targetID)...)
+}
+
+// findBucketNotificationTargets returns the bucket's notification configuration.
+// A bucket without notifications has an empty notification configuration.
+func findBucketNotificationTargets(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*awstypes.NotificationConfiguration, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if errors.Is(err, tfresource.ErrEmptyResult) {
+		return &awstypes.NotificationConfiguration{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &awstypes.NotificationConfiguration{
+		EventBridgeConfiguration:     output.EventBridgeConfiguration,
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}, nil
+}
+
+func findBucketNotificationTargetByThreePartKey(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner, targetID string) (*bucketNotificationTargetResourceModel, error) {
+	notificationConfiguration, err := findBucketNotificationTargets(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if i := slices.IndexFunc(notificationConfiguration.LambdaFunctionConfigurations, func(v awstypes.LambdaFunctionConfiguration) bool {
+		return aws.ToString(v.Id) == targetID
+	}); i >= 0 {
+		v := notificationConfiguration.LambdaFunctionConfigurations[i]
+		target := newBucketNotificationTargetModel(ctx, v.Events, v.Filter)
+		target.LambdaFunctionARN = fwtypes.ARNValue(aws.ToString(v.LambdaFunctionArn))
+
+		return target, nil
+	}
+
+	if i := slices.IndexFunc(notificationConfiguration.QueueConfigurations, func(v awstypes.QueueConfiguration) bool {
+		return aws.ToString(v.Id) == targetID
+	}); i >= 0 {
+		v := notificationConfiguration.QueueConfigurations[i]
+		target := newBucketNotificationTargetModel(ctx, v.Events, v.Filter)
+		target.QueueARN = fwtypes.ARNValue(aws.ToString(v.QueueArn))
+
+		return target, nil
+	}
+
+	if i := slices.IndexFunc(notificationConfiguration.TopicConfigurations, func(v awstypes.TopicConfiguration) bool {
+		return aws.ToString(v.Id) == targetID
+	}); i >= 0 {
+		v := notificationConfiguration.TopicConfigurations[i]
+		target := newBucketNotificationTargetModel(ctx, v.Events, v.Filter)
+		target.TopicARN = fwtypes.ARNValue(aws.ToString(v.TopicArn))
+
+		return target, nil
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func putBucketNotificationTargets(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, notificationConfiguration *awstypes.NotificationConfiguration) error {
+	input := s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: notificationConfiguration,
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.PutBucketNotificationConfiguration(ctx, &input)
+	}, errCodeNoSuchBucket)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "NotificationConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	return err
+}
+
+// notificationTargetIDs returns the IDs of all Lambda function, SQS queue and SNS topic notification targets.
+func notificationTargetIDs(notificationConfiguration *awstypes.NotificationConfiguration) []string {
+	var ids []string
+
+	for _, v := range notificationConfiguration.LambdaFunctionConfigurations {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+	for _, v := range notificationConfiguration.QueueConfigurations {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+	for _, v := range notificationConfiguration.TopicConfigurations {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+
+	return ids
+}
+
+// removeNotificationTargets removes the notification targets with the specified IDs
+// and returns whether any target was removed.
+func removeNotificationTargets(notificationConfiguration *awstypes.NotificationConfiguration, ids ...string) bool {
+	n := len(notificationTargetIDs(notificationConfiguration))
+
+	notificationConfiguration.LambdaFunctionConfigurations = slices.DeleteFunc(notificationConfiguration.LambdaFunctionConfigurations, func(v awstypes.LambdaFunctionConfiguration) bool {
+		return slices.Contains(ids, aws.ToString(v.Id))
+	})
+	notificationConfiguration.QueueConfigurations = slices.DeleteFunc(notificationConfiguration.QueueConfigurations, func(v awstypes.QueueConfiguration) bool {
+		return slices.Contains(ids, aws.ToString(v.Id))
+	})
+	notificationConfiguration.TopicConfigurations = slices.DeleteFunc(notificationConfiguration.TopicConfigurations, func(v awstypes.TopicConfiguration) bool {
+		return slices.Contains(ids, aws.ToString(v.Id))
+	})
+
+	return len(notificationTargetIDs(notificationConfiguration)) != n
+}
+
+type bucketNotificationTargetResourceModel struct {
+	framework.WithRegionModel
+	Bucket              types.String        `tfsdk:"bucket"`
+	Events              fwtypes.SetOfString `tfsdk:"events"`
+	ExpectedBucketOwner types.String        `tfsdk:"expected_bucket_owner"`
+	FilterPrefix        types.String        `tfsdk:"filter_prefix"`
+	FilterSuffix        types.String        `tfsdk:"filter_suffix"`
+	LambdaFunctionARN   fwtypes.ARN         `tfsdk:"lambda_function_arn"`
+	QueueARN            fwtypes.ARN         `tfsdk:"queue_arn"`
+	TargetID            types.String        `tfsdk:"target_id"`
+	TopicARN            fwtypes.ARN         `tfsdk:"topic_arn"`
+}
+
+func newBucketNotificationTargetModel(ctx context.Context, events []awstypes.Event, filter *awstypes.NotificationConfigurationFilter) *bucketNotificationTargetResourceModel {
+	target := &bucketNotificationTargetResourceModel{
+		Events:            fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, enum.Slice(events...)),
+		FilterPrefix:      types.StringNull(),
+		FilterSuffix:      types.StringNull(),
+		LambdaFunctionARN: fwtypes.ARNNull(),
+		QueueARN:          fwtypes.ARNNull(),
+		TopicARN:          fwtypes.ARNNull(),
+	}
+
+	if filter != nil {
+		filterRules := flattenNotificationConfigurationFilter(filter)
+		if v, ok := filterRules["filter_prefix"].(string); ok && v != "" {
+			target.FilterPrefix = types.StringValue(v)
+		}
+		if v, ok := filterRules["filter_suffix"].(string); ok && v != "" {
+			target.FilterSuffix = types.StringValue(v)
+		}
+	}
+
+	return target
+}
+
+// addTo adds the notification target to the bucket's notification configuration.
+func (m bucketNotificationTargetResourceModel) addTo(ctx context.Context, notificationConfiguration *awstypes.NotificationConfiguration) {
+	id := fwflex.StringFromFramework(ctx, m.TargetID)
+	events := fwflex.ExpandFrameworkStringyValueSet[awstypes.Event](ctx, m.Events)
+
+	var filterRules []awstypes.FilterRule
+	if v := m.FilterPrefix.ValueString(); v != "" {
+		filterRules = append(filterRules, awstypes.FilterRule{
+			Name:  awstypes.FilterRuleNamePrefix,
+			Value: aws.String(v),
+		})
+	}
+	if v := m.FilterSuffix.ValueString(); v != "" {
+		filterRules = append(filterRules, awstypes.FilterRule{
+			Name:  awstypes.FilterRuleNameSuffix,
+			Value: aws.String(v),
+		})
+	}
+	var filter *awstypes.NotificationConfigurationFilter
+	if len(filterRules) > 0 {
+		filter = &awstypes.NotificationConfigurationFilter{
+			Key: &awstypes.S3KeyFilter{
+				FilterRules: filterRules,
+			},
+		}
+	}
+
+	switch {
+	case !m.LambdaFunctionARN.IsNull():
+		notificationConfiguration.LambdaFunctionConfigurations = append(notificationConfiguration.LambdaFunctionConfigurations, awstypes.LambdaFunctionConfiguration{
+			Events:            events,
+			Filter:            filter,
+			Id:                id,
+			LambdaFunctionArn: fwflex.StringFromFramework(ctx, m.LambdaFunctionARN),
+		})
+	case !m.QueueARN.IsNull():
+		notificationConfiguration.QueueConfigurations = append(notificationConfiguration.QueueConfigurations, awstypes.QueueConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       id,
+			QueueArn: fwflex.StringFromFramework(ctx, m.QueueARN),
+		})
+	case !m.TopicARN.IsNull():
+		notificationConfiguration.TopicConfigurations = append(notificationConfiguration.TopicConfigurations, awstypes.TopicConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       id,
+			TopicArn: fwflex.StringFromFramework(ctx, m.TopicARN),
+		})
+	}
+}
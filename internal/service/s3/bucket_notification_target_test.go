@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationTarget_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_target.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	queueResourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_basic(rName, "images/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "images/"),
+					resource.TestCheckNoResourceAttr(resourceName, "filter_suffix"),
+					resource.TestCheckNoResourceAttr(resourceName, "lambda_function_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", queueResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "target_id", rName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrTopicARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccBucketRuleImportStateIdFunc(resourceName, "target_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "target_id",
+			},
+			{
+				Config: testAccBucketNotificationTargetConfig_basic(rName, "videos/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "videos/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationTarget_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_basic(rName, "images/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketNotificationTarget, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationTarget_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_notification_target.test1"
+	resourceName2 := "aws_s3_bucket_notification_target.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName1),
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(resourceName2, "filter_suffix", ".mp4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationTargetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_notification_target" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketNotificationTargetByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner], rs.Primary.Attributes["target_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Notification Target %s still exists", rs.Primary.Attributes["target_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationTargetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindBucketNotificationTargetByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner], rs.Primary.Attributes["target_id"])
+
+		return err
+	}
+}
+
+func testAccBucketNotificationTargetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "s3.amazonaws.com"},
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccBucketNotificationTargetConfig_basic(rName, filterPrefix string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_target" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  target_id     = %[1]q
+  events        = ["s3:ObjectCreated:*"]
+  filter_prefix = %[2]q
+  queue_arn     = aws_sqs_queue.test.arn
+}
+`, rName, filterPrefix))
+}
+
+func testAccBucketNotificationTargetConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_target" "test1" {
+  bucket        = aws_s3_bucket.test.bucket
+  target_id     = "%[1]s-1"
+  events        = ["s3:ObjectCreated:*"]
+  filter_prefix = "images/"
+  queue_arn     = aws_sqs_queue.test.arn
+}
+
+resource "aws_s3_bucket_notification_target" "test2" {
+  bucket        = aws_s3_bucket.test.bucket
+  target_id     = "%[1]s-2"
+  events        = ["s3:ObjectRemoved:*"]
+  filter_suffix = ".mp4"
+  queue_arn     = aws_sqs_queue.test.arn
+}
+`, rName))
+}
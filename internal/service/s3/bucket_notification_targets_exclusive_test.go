@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationTargetsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_targets_exclusive.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	targetResourceName1 := "aws_s3_bucket_notification_target.test1"
+	targetResourceName2 := "aws_s3_bucket_notification_target.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_ids.*", targetResourceName1, "target_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_ids.*", targetResourceName2, "target_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrBucket),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrBucket,
+			},
+		},
+	})
+}
+
+// A target added out of band should be removed.
+func TestAccS3BucketNotificationTargetsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_targets_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetsExclusiveExists(ctx, resourceName),
+					testAccCheckBucketNotificationTargetsExclusiveAddTarget(ctx, resourceName, "aws_sqs_queue.test", rName+"-oob"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketNotificationTargetsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationTargetsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationTargetsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketNotificationConfiguration(ctx, conn, bucket, rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+		if err != nil {
+			return err
+		}
+
+		count := len(output.LambdaFunctionConfigurations) + len(output.QueueConfigurations) + len(output.TopicConfigurations)
+		if got, want := rs.Primary.Attributes["target_ids.#"], strconv.Itoa(count); got != want {
+			return fmt.Errorf("S3 Bucket (%s) notification target count: got %s, want %s", bucket, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationTargetsExclusiveAddTarget(ctx context.Context, n, queueResourceName, targetID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		queue, ok := s.RootModule().Resources[queueResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", queueResourceName)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+		if err != nil {
+			return err
+		}
+
+		input := s3.PutBucketNotificationConfigurationInput{
+			Bucket: aws.String(bucket),
+			NotificationConfiguration: &awstypes.NotificationConfiguration{
+				EventBridgeConfiguration:     output.EventBridgeConfiguration,
+				LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+				QueueConfigurations: append(output.QueueConfigurations, awstypes.QueueConfiguration{
+					Events:   []awstypes.Event{awstypes.EventS3ObjectRestoreCompleted},
+					Id:       aws.String(targetID),
+					QueueArn: aws.String(queue.Primary.Attributes[names.AttrARN]),
+				}),
+				TopicConfigurations: output.TopicConfigurations,
+			},
+		}
+		_, err = conn.PutBucketNotificationConfiguration(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccBucketNotificationTargetsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_multiple(rName), `
+resource "aws_s3_bucket_notification_targets_exclusive" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  target_ids = [
+    aws_s3_bucket_notification_target.test1.target_id,
+    aws_s3_bucket_notification_target.test2.target_id,
+  ]
+}
+`)
+}
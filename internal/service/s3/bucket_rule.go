@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bucket configurations that can be managed one rule at a time, e.g. by aws_s3_bucket_lifecycle_rule.
+const (
+	bucketConfigurationCORS         = "cors"
+	bucketConfigurationLifecycle    = "lifecycle"
+	bucketConfigurationNotification = "notification"
+)
+
+// bucketConfigurationMutexKey returns the conns.GlobalMutexKV key that serializes the read-modify-write
+// of a bucket configuration shared by per-rule resources and their exclusive counterpart.
+func bucketConfigurationMutexKey(bucket, configuration string) string {
+	return fmt.Sprintf("s3-bucket-%s-configuration-%s", configuration, bucket)
+}
+
+// parseBucketRuleImportID parses an import ID of the form BUCKET,RULE_ID or BUCKET,EXPECTED_BUCKET_OWNER,RULE_ID
+// for a per-rule resource, e.g. aws_s3_bucket_lifecycle_rule.
+func parseBucketRuleImportID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], "", parts[1], nil
+	}
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sRULE_ID or BUCKET%[2]sEXPECTED_BUCKET_OWNER%[2]sRULE_ID", id, resourceIDSeparator)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestParseBucketRuleImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectError         bool
+		ExpectedBucket      string
+		ExpectedBucketOwner string
+		ExpectedRuleID      string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "bucket only",
+			InputID:     "example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty rule ID",
+			InputID:     "example,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "example," + acctest.Ct12Digit + ",rule,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:       "valid ID with bucket and rule ID",
+			InputID:        "example,rule",
+			ExpectedBucket: "example",
+			ExpectedRuleID: "rule",
+		},
+		{
+			TestName:            "valid ID with bucket, bucket owner and rule ID",
+			InputID:             "example," + acctest.Ct12Digit + ",rule",
+			ExpectedBucket:      "example",
+			ExpectedBucketOwner: acctest.Ct12Digit,
+			ExpectedRuleID:      "rule",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotBucket, gotExpectedBucketOwner, gotRuleID, err := tfs3.ParseBucketRuleImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotBucket != testCase.ExpectedBucket {
+				t.Errorf("got bucket %s, expected %s", gotBucket, testCase.ExpectedBucket)
+			}
+
+			if gotExpectedBucketOwner != testCase.ExpectedBucketOwner {
+				t.Errorf("got ExpectedBucketOwner %s, expected %s", gotExpectedBucketOwner, testCase.ExpectedBucketOwner)
+			}
+
+			if gotRuleID != testCase.ExpectedRuleID {
+				t.Errorf("got rule ID %s, expected %s", gotRuleID, testCase.ExpectedRuleID)
+			}
+		})
+	}
+}
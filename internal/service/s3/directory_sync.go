@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"mime"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithModel[directorySyncResourceModel]
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"delete_removed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"etags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"exclude": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"include": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.sync(ctx, &data, nil)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.conn(ctx, data.Bucket.ValueString())
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+
+	remote, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) objects (%s)", bucket, keyPrefix), err.Error())
+
+		return
+	}
+
+	files := fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)
+	etags := fwflex.ExpandFrameworkStringValueMap(ctx, data.ETags)
+
+	// Objects that are missing or have been modified out of band are recorded with an empty hash,
+	// so that the next plan uploads them again.
+	for key := range files {
+		etag, ok := remote[key]
+		if !ok {
+			delete(files, key)
+			delete(etags, key)
+			continue
+		}
+
+		if etag != etags[key] {
+			files[key] = ""
+			etags[key] = etag
+		}
+	}
+
+	// Remote objects that are not present locally are recorded so that the next plan removes them.
+	if data.DeleteRemoved.ValueBool() {
+		include := fwflex.ExpandFrameworkStringValueSet(ctx, data.Include)
+		exclude := fwflex.ExpandFrameworkStringValueSet(ctx, data.Exclude)
+
+		for key, etag := range remote {
+			if _, ok := files[key]; ok {
+				continue
+			}
+
+			if tfio.FileIncluded(strings.TrimPrefix(key, keyPrefix), include, exclude) {
+				files[key] = ""
+				etags[key] = etag
+			}
+		}
+	}
+
+	data.Files = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, files)
+	data.ETags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, etags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.sync(ctx, &new, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.conn(ctx, data.Bucket.ValueString())
+	bucket := data.Bucket.ValueString()
+
+	keys := slices.Sorted(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)))
+	err := deleteObjectsByKey(ctx, conn, bucket, keys)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) objects (%s)", bucket, data.KeyPrefix.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() || plan.KeyPrefix.IsUnknown() || plan.Include.IsUnknown() || plan.Exclude.IsUnknown() {
+		plan.Files = types.MapUnknown(types.StringType)
+		plan.ETags = types.MapUnknown(types.StringType)
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+		return
+	}
+
+	// Hash the local files at plan time so that content changes show up as a diff.
+	files, err := directorySyncLocalFiles(plan.Source.ValueString(), plan.KeyPrefix.ValueString(), fwflex.ExpandFrameworkStringValueSet(ctx, plan.Include), fwflex.ExpandFrameworkStringValueSet(ctx, plan.Exclude))
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrSource), "Reading Local Directory", err.Error())
+
+		return
+	}
+
+	plan.Files = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, files)
+
+	if request.State.Raw.IsNull() {
+		plan.ETags = types.MapUnknown(types.StringType)
+	} else {
+		var state directorySyncResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Files.Equal(state.Files) {
+			plan.ETags = state.ETags
+		} else {
+			plan.ETags = types.MapUnknown(types.StringType)
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+func (r *directorySyncResource) conn(ctx context.Context, bucket string) *s3.Client {
+	if isDirectoryBucket(bucket) {
+		return r.Meta().S3ExpressClient(ctx)
+	}
+
+	return r.Meta().S3Client(ctx)
+}
+
+// sync uploads new and changed local files and, if delete_removed is set, deletes objects that are no longer present locally.
+// old is nil on create.
+func (r *directorySyncResource) sync(ctx context.Context, new, old *directorySyncResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.conn(ctx, new.Bucket.ValueString())
+	bucket, keyPrefix := new.Bucket.ValueString(), new.KeyPrefix.ValueString()
+
+	source, err := homedir.Expand(new.Source.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("expanding homedir in source (%s)", new.Source.ValueString()), err.Error())
+
+		return diags
+	}
+
+	want := fwflex.ExpandFrameworkStringValueMap(ctx, new.Files)
+	have := map[string]string{}
+	etags := map[string]string{}
+	if old != nil {
+		have = fwflex.ExpandFrameworkStringValueMap(ctx, old.Files)
+		etags = fwflex.ExpandFrameworkStringValueMap(ctx, old.ETags)
+	}
+
+	if old == nil && new.DeleteRemoved.ValueBool() {
+		remote, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading S3 Bucket (%s) objects (%s)", bucket, keyPrefix), err.Error())
+
+			return diags
+		}
+
+		include := fwflex.ExpandFrameworkStringValueSet(ctx, new.Include)
+		exclude := fwflex.ExpandFrameworkStringValueSet(ctx, new.Exclude)
+
+		for key := range remote {
+			if tfio.FileIncluded(strings.TrimPrefix(key, keyPrefix), include, exclude) {
+				have[key] = ""
+			}
+		}
+	}
+
+	uploader := manager.NewUploader(conn)
+
+	for _, key := range slices.Sorted(maps.Keys(want)) {
+		if hash, ok := have[key]; ok && hash == want[key] {
+			continue
+		}
+
+		etag, err := uploadDirectorySyncFile(ctx, uploader, bucket, key, filepath.Join(source, filepath.FromSlash(strings.TrimPrefix(key, keyPrefix))))
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("uploading S3 Object (%s) to Bucket (%s)", key, bucket), err.Error())
+
+			return diags
+		}
+
+		etags[key] = etag
+	}
+
+	// Objects that are no longer present locally stop being tracked and are only deleted if delete_removed is set.
+	var toDelete []string
+	for _, key := range slices.Sorted(maps.Keys(have)) {
+		if _, ok := want[key]; !ok {
+			if new.DeleteRemoved.ValueBool() {
+				toDelete = append(toDelete, key)
+			}
+			delete(etags, key)
+		}
+	}
+
+	if err := deleteObjectsByKey(ctx, conn, bucket, toDelete); err != nil {
+		diags.AddError(fmt.Sprintf("deleting S3 Bucket (%s) objects (%s)", bucket, keyPrefix), err.Error())
+
+		return diags
+	}
+
+	new.ETags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, etags)
+
+	return diags
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, bucket, key, filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", filename, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if v := mime.TypeByExtension(filepath.Ext(filename)); v != "" {
+		input.ContentType = aws.String(v)
+	}
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// findObjectETagsByPrefix returns the ETags of all objects whose keys begin with the specified prefix.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
+
+// deleteObjectsByKey deletes the specified objects in batches of up to 1000.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	for chunk := range slices.Chunk(keys, 1000) {
+		toDelete := tfslices.ApplyToAll(chunk, func(v string) awstypes.ObjectIdentifier {
+			return awstypes.ObjectIdentifier{
+				Key: aws.String(v),
+			}
+		})
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// directorySyncLocalFiles walks the source directory and returns the SHA256 hash of each included file,
+// keyed by object key.
+func directorySyncLocalFiles(source, keyPrefix string, include, exclude []string) (map[string]string, error) {
+	files := make(map[string]string)
+
+	err := tfio.WalkFiles(source, include, exclude, func(name, filename string, _ fs.FileInfo) error {
+		hash, err := fileSHA256(filename)
+		if err != nil {
+			return err
+		}
+
+		files[keyPrefix+name] = hash
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func fileSHA256(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type directorySyncResourceModel struct {
+	framework.WithRegionModel
+	Bucket        types.String        `tfsdk:"bucket"`
+	DeleteRemoved types.Bool          `tfsdk:"delete_removed"`
+	ETags         types.Map           `tfsdk:"etags"`
+	Exclude       fwtypes.SetOfString `tfsdk:"exclude"`
+	Files         types.Map           `tfsdk:"files"`
+	Include       fwtypes.SetOfString `tfsdk:"include"`
+	KeyPrefix     types.String        `tfsdk:"key_prefix"`
+	Source        types.String        `tfsdk:"source"`
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySyncLocalFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html":          "<html></html>",
+		"css/site.css":        "body {}",
+		"docs/api/index.html": "<html>api</html>",
+		".git/config":         "[core]",
+	})
+
+	testCases := map[string]struct {
+		keyPrefix string
+		include   []string
+		exclude   []string
+		expected  []string
+	}{
+		"all": {
+			expected: []string{".git/config", "css/site.css", "docs/api/index.html", "index.html"},
+		},
+		"prefix": {
+			keyPrefix: "site/",
+			exclude:   []string{".git/**"},
+			expected:  []string{"site/css/site.css", "site/docs/api/index.html", "site/index.html"},
+		},
+		"include": {
+			include:  []string{"**/*.html"},
+			expected: []string{"docs/api/index.html", "index.html"},
+		},
+		"include and exclude": {
+			include:  []string{"**/*.html"},
+			exclude:  []string{"docs/**"},
+			expected: []string{"index.html"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := tfs3.DirectorySyncLocalFiles(dir, testCase.keyPrefix, testCase.include, testCase.exclude)
+
+			if err != nil {
+				t.Fatalf("DirectorySyncLocalFiles() err: %v", err)
+			}
+
+			if got, want := len(files), len(testCase.expected); got != want {
+				t.Fatalf("DirectorySyncLocalFiles() returned %d files, want %d: %v", got, want, files)
+			}
+
+			for _, key := range testCase.expected {
+				if hash, ok := files[key]; !ok || len(hash) != 64 {
+					t.Errorf("DirectorySyncLocalFiles() key %q: hash %q", key, hash)
+				}
+			}
+		})
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "site/index.html", "text/html; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+						"index.html": "<html><body></body></html>",
+						"img/a.svg":  "<svg></svg>",
+					})
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/img/a.svg"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceDirectorySync, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html":      "<html></html>",
+		"docs/index.html": "<html>docs</html>",
+		"notes.txt":       "notes",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_includeExclude(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.index.html"),
+				),
+			},
+		},
+	})
+}
+
+// An object whose local file is removed should be kept when delete_removed is not set.
+func TestAccS3DirectorySync_keepRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+// An object added out of band under the key prefix should be removed.
+func TestAccS3DirectorySync_deleteRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", acctest.CtTrue),
+					testAccCheckDirectorySyncPutObject(ctx, resourceName, "site/stale.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// testAccCheckDirectorySyncExists verifies that the objects under the key prefix match the resource's state.
+func testAccCheckDirectorySyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		objects, err := tfs3.FindObjectETagsByPrefix(ctx, conn, bucket, rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["files.%"], strconv.Itoa(len(objects)); got != want {
+			return fmt.Errorf("S3 Bucket (%s) object count: got %s, want %s", bucket, got, want)
+		}
+
+		for key, etag := range objects {
+			if got, want := rs.Primary.Attributes["etags."+key], etag; got != want {
+				return fmt.Errorf("S3 Object (%s) ETag: got %s, want %s", key, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(ctx context.Context, n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.ContentType), contentType; !strings.EqualFold(got, want) {
+			return fmt.Errorf("S3 Object (%s) Content-Type: got %s, want %s", key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncPutObject(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := s3.PutObjectInput{
+			Body:   strings.NewReader("stale"),
+			Bucket: aws.String(rs.Primary.Attributes[names.AttrBucket]),
+			Key:    aws.String(key),
+		}
+		_, err := conn.PutObject(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_includeExclude(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  source  = %[2]q
+  include = ["**/*.html"]
+  exclude = ["docs/**"]
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_deleteRemoved(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source         = %[2]q
+  delete_removed = true
+}
+`, rName, source)
+}
@@ -10,13 +10,19 @@ var (
 	ResourceBucketACL                               = resourceBucketACL
 	ResourceBucketAnalyticsConfiguration            = resourceBucketAnalyticsConfiguration
 	ResourceBucketCorsConfiguration                 = resourceBucketCorsConfiguration
+	ResourceBucketCORSRule                          = newBucketCORSRuleResource
+	ResourceBucketCORSRulesExclusive                = newBucketCORSRulesExclusiveResource
 	ResourceBucketIntelligentTieringConfiguration   = resourceBucketIntelligentTieringConfiguration
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = newBucketLifecycleConfigurationResource
+	ResourceBucketLifecycleRule                     = newBucketLifecycleRuleResource
+	ResourceBucketLifecycleRulesExclusive           = newBucketLifecycleRulesExclusiveResource
 	ResourceBucketLogging                           = resourceBucketLogging
 	ResourceBucketMetadataConfiguration             = newBucketMetadataConfigurationResource
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
+	ResourceBucketNotificationTarget                = newBucketNotificationTargetResource
+	ResourceBucketNotificationTargetsExclusive      = newBucketNotificationTargetsExclusiveResource
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
 	ResourceBucketObject                            = resourceBucketObject
 	ResourceBucketOwnershipControls                 = resourceBucketOwnershipControls
@@ -28,21 +34,26 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectorySync                           = newDirectorySyncResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                            = bucketUpdateTags
 	BucketRegionalDomainName                    = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain              = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions                     = deleteAllObjectVersions
+	DirectorySyncLocalFiles                     = directorySyncLocalFiles
 	EmptyBucket                                 = emptyBucket
 	FindAnalyticsConfiguration                  = findAnalyticsConfiguration
 	FindBucket                                  = findBucket
 	FindBucketABAC                              = findBucketABAC
 	FindBucketACL                               = findBucketACL
 	FindBucketAccelerateConfiguration           = findBucketAccelerateConfiguration
+	FindBucketCORSRuleByThreePartKey            = findBucketCORSRuleByThreePartKey
 	FindBucketLifecycleConfiguration            = findBucketLifecycleConfiguration
+	FindBucketLifecycleRuleByThreePartKey       = findBucketLifecycleRuleByThreePartKey
 	FindBucketMetadataConfigurationByTwoPartKey = findBucketMetadataConfigurationByTwoPartKey
 	FindBucketNotificationConfiguration         = findBucketNotificationConfiguration
+	FindBucketNotificationTargetByThreePartKey  = findBucketNotificationTargetByThreePartKey
 	FindBucketPolicy                            = findBucketPolicy
 	FindBucketRequestPayment                    = findBucketRequestPayment
 	FindBucketVersioning                        = findBucketVersioning
@@ -53,6 +64,7 @@ var (
 	FindLoggingEnabled                          = findLoggingEnabled
 	FindMetricsConfiguration                    = findMetricsConfiguration
 	FindObjectByBucketAndKey                    = findObjectByBucketAndKey
+	FindObjectETagsByPrefix                     = findObjectETagsByPrefix
 	FindObjectLockConfiguration                 = findObjectLockConfiguration
 	FindOwnershipControls                       = findOwnershipControls
 	FindPublicAccessBlockConfiguration          = findPublicAccessBlockConfiguration
@@ -80,6 +92,7 @@ var (
 	ParseResourceID           = parseResourceID
 	CreateBucketACLResourceID = createBucketACLResourceID
 	ParseBucketACLResourceID  = parseBucketACLResourceID
+	ParseBucketRuleImportID   = parseBucketRuleImportID
 
 	DirectoryBucketNameRegex = directoryBucketNameRegex
 
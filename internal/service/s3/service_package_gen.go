@@ -36,18 +36,54 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Bucket ABAC",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newBucketCORSRuleResource,
+			TypeName: "aws_s3_bucket_cors_rule",
+			Name:     "Bucket CORS Rule",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newBucketCORSRulesExclusiveResource,
+			TypeName: "aws_s3_bucket_cors_rules_exclusive",
+			Name:     "Bucket CORS Rules Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newBucketLifecycleConfigurationResource,
 			TypeName: "aws_s3_bucket_lifecycle_configuration",
 			Name:     "Bucket Lifecycle Configuration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newBucketLifecycleRuleResource,
+			TypeName: "aws_s3_bucket_lifecycle_rule",
+			Name:     "Bucket Lifecycle Rule",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newBucketLifecycleRulesExclusiveResource,
+			TypeName: "aws_s3_bucket_lifecycle_rules_exclusive",
+			Name:     "Bucket Lifecycle Rules Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newBucketMetadataConfigurationResource,
 			TypeName: "aws_s3_bucket_metadata_configuration",
 			Name:     "Bucket Metadata Configuration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newBucketNotificationTargetResource,
+			TypeName: "aws_s3_bucket_notification_target",
+			Name:     "Bucket Notification Target",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newBucketNotificationTargetsExclusiveResource,
+			TypeName: "aws_s3_bucket_notification_targets_exclusive",
+			Name:     "Bucket Notification Targets Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
@@ -58,6 +94,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newDirectorySyncResource,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
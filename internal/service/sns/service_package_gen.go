@@ -78,6 +78,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"sns:CreateTopic",
+					"sns:GetTopicAttributes",
+					"sns:SetTopicAttributes",
+					"sns:TagResource",
+				},
+				Update: []string{
+					"sns:SetTopicAttributes",
+					"sns:TagResource",
+					"sns:UntagResource",
+				},
+				Delete: []string{
+					"sns:DeleteTopic",
+				},
+			},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
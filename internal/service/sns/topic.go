@@ -215,6 +215,7 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="sns:CreateTopic;sns:GetTopicAttributes;sns:SetTopicAttributes;sns:TagResource", update="sns:SetTopicAttributes;sns:TagResource;sns:UntagResource", delete="sns:DeleteTopic")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
 // @Testing(existsType="map[string]string")
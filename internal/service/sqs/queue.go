@@ -194,6 +194,7 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @Permissions(create="sqs:CreateQueue;sqs:GetQueueAttributes;sqs:TagQueue", update="sqs:SetQueueAttributes;sqs:TagQueue;sqs:UntagQueue", delete="sqs:DeleteQueue")
 // @IdentityVersion(1)
 // @CustomInherentRegionIdentity("url", "parseQueueURL")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
@@ -62,6 +62,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			Permissions: inttypes.ServicePackageResourcePermissions{
+				Create: []string{
+					"sqs:CreateQueue",
+					"sqs:GetQueueAttributes",
+					"sqs:TagQueue",
+				},
+				Update: []string{
+					"sqs:SetQueueAttributes",
+					"sqs:TagQueue",
+					"sqs:UntagQueue",
+				},
+				Delete: []string{
+					"sqs:DeleteQueue",
+				},
+			},
 		},
 		{
 			Factory:  resourceQueuePolicy,
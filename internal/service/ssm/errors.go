@@ -4,5 +4,7 @@
 package ssm
 
 const (
+	errCodeThrottlingException = "ThrottlingException"
+	errCodeTooManyUpdates      = "TooManyUpdates"
 	errCodeValidationException = "ValidationException"
 )
@@ -13,6 +13,7 @@ var (
 	ResourceMaintenanceWindowTarget = resourceMaintenanceWindowTarget
 	ResourceMaintenanceWindowTask   = resourceMaintenanceWindowTask
 	ResourceParameter               = resourceParameter
+	ResourceParameters              = resourceParameters
 	ResourcePatchBaseline           = resourcePatchBaseline
 	ResourcePatchGroup              = resourcePatchGroup
 	ResourceResourceDataSync        = resourceResourceDataSync
@@ -27,8 +28,12 @@ var (
 	FindMaintenanceWindowTargetByTwoPartKey            = findMaintenanceWindowTargetByTwoPartKey
 	FindMaintenanceWindowTaskByTwoPartKey              = findMaintenanceWindowTaskByTwoPartKey
 	FindParameterByName                                = findParameterByName
+	FindParametersByPath                               = findParametersByPath
 	FindPatchBaselineByID                              = findPatchBaselineByID
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
 	FindServiceSettingByID                             = findServiceSettingByID
+	ExpandParametersWriteOnly                          = expandParametersWriteOnly
+	ParametersDiff                                     = parametersDiff
+	ParametersQueryPath                                = parametersQueryPath
 )
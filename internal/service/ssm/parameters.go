@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func resourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceParametersImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrParameters: {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapKeyLenBetween(1, 2047),
+					validation.MapKeyMatch(regexache.MustCompile(`^[^/]`), "must not start with '/'"),
+				),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Plugin SDK v2 doesn't support write-only maps, so the map is JSON-encoded.
+			"parameters_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsJSON,
+				RequiredWith: []string{"parameters_wo_version"},
+			},
+			"parameters_wo_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"parameters_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"parameters_wo"},
+			},
+			names.AttrPath: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return parametersPath(old) == parametersPath(new)
+				},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 2048),
+					validation.StringMatch(regexache.MustCompile(`^/`), "must start with '/'"),
+				),
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ParameterTypeString,
+				ValidateDiagFunc: enum.Validate[awstypes.ParameterType](),
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("parameters_wo_keys", func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
+				return diff.HasChange("parameters_wo_version") || !diff.NewValueKnown("parameters_wo_version")
+			}),
+		),
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := parametersPath(d.Get(names.AttrPath).(string))
+	values := flex.ExpandStringValueMap(d.Get(names.AttrParameters).(map[string]any))
+
+	valuesWO, di := getParametersWriteOnlyValues(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := checkParametersWriteOnlyKeys(values, valuesWO); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The ID is set first so that parameters created before a failure are tracked.
+	d.SetId(path)
+	d.Set("parameters_wo_keys", slices.Collect(maps.Keys(valuesWO)))
+
+	put := maps.Clone(values)
+	maps.Copy(put, valuesWO)
+	typ, keyID := awstypes.ParameterType(d.Get(names.AttrType).(string)), d.Get(names.AttrKeyID).(string)
+
+	if err := putParameters(ctx, conn, path, put, typ, keyID, false, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	output, err := findParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	if !d.IsNewResource() && len(output) == 0 {
+		log.Printf("[WARN] SSM Parameters %s not found, removing from state", path)
+		d.SetId("")
+		return diags
+	}
+
+	// Only parameters managed by this resource are refreshed.
+	// Managed parameters that no longer exist are removed so that they are recreated.
+	values := make(map[string]string)
+	for key := range d.Get(names.AttrParameters).(map[string]any) {
+		if v, ok := output[key]; ok {
+			values[key] = aws.ToString(v.Value)
+		}
+	}
+
+	var keysWO []string
+	for _, key := range flex.ExpandStringValueSet(d.Get("parameters_wo_keys").(*schema.Set)) {
+		if _, ok := output[key]; ok {
+			keysWO = append(keysWO, key)
+		}
+	}
+
+	d.Set(names.AttrParameters, values)
+	d.Set("parameters_wo_keys", keysWO)
+	d.Set(names.AttrPath, path)
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	typ, keyID := awstypes.ParameterType(d.Get(names.AttrType).(string)), d.Get(names.AttrKeyID).(string)
+
+	o, n := d.GetChange(names.AttrParameters)
+	old, new := flex.ExpandStringValueMap(o.(map[string]any)), flex.ExpandStringValueMap(n.(map[string]any))
+	put, remove := parametersDiff(old, new)
+
+	// A change of type or KMS key applies to all parameters.
+	if d.HasChanges(names.AttrType, names.AttrKeyID) {
+		put = maps.Clone(new)
+	}
+
+	keysWO := flex.ExpandStringValueSet(d.Get("parameters_wo_keys").(*schema.Set))
+
+	if d.HasChanges("parameters_wo_version", names.AttrType, names.AttrKeyID) {
+		valuesWO, di := getParametersWriteOnlyValues(d)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := checkParametersWriteOnlyKeys(new, valuesWO); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if d.HasChange("parameters_wo_version") {
+			o, _ := d.GetChange("parameters_wo_keys")
+			for _, key := range flex.ExpandStringValueSet(o.(*schema.Set)) {
+				if _, ok := valuesWO[key]; !ok {
+					remove = append(remove, key)
+				}
+			}
+		}
+
+		maps.Copy(put, valuesWO)
+		keysWO = slices.Collect(maps.Keys(valuesWO))
+	}
+
+	// Parameters moved between parameters and parameters_wo are overwritten rather than deleted.
+	remove = slices.DeleteFunc(remove, func(key string) bool {
+		_, ok := put[key]
+		_, ok2 := new[key]
+		return ok || ok2
+	})
+
+	if err := putParameters(ctx, conn, path, put, typ, keyID, true, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+	}
+
+	if err := deleteParameters(ctx, conn, path, remove, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+	}
+
+	d.Set("parameters_wo_keys", keysWO)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	keys := slices.Collect(maps.Keys(d.Get(names.AttrParameters).(map[string]any)))
+	keys = append(keys, flex.ExpandStringValueSet(d.Get("parameters_wo_keys").(*schema.Set))...)
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", path)
+	if err := deleteParameters(ctx, conn, path, keys, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", path, err)
+	}
+
+	return diags
+}
+
+func resourceParametersImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := parametersPath(d.Id())
+	output, err := findParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading SSM Parameters (%s): %w", path, err)
+	}
+
+	if len(output) == 0 {
+		return nil, fmt.Errorf("no SSM Parameters found under path (%s)", path)
+	}
+
+	// All parameters under the path are imported as managed parameters.
+	// The type default is taken from the first parameter.
+	values := make(map[string]string, len(output))
+	for key, v := range output {
+		values[key] = aws.ToString(v.Value)
+	}
+	first := output[slices.Min(slices.Collect(maps.Keys(output)))]
+
+	d.SetId(path)
+	d.Set(names.AttrParameters, values)
+	d.Set(names.AttrType, first.Type)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parametersPath returns the path with a trailing slash.
+func parametersPath(path string) string {
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+
+	return path
+}
+
+// parametersQueryPath returns the path to pass to GetParametersByPath, which
+// doesn't accept a trailing slash other than for the root path.
+func parametersQueryPath(path string) string {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	return path
+}
+
+// parametersDiff returns the keys of parameters to put and delete to change
+// the old parameter values to the new ones.
+func parametersDiff(old, new map[string]string) (map[string]string, []string) {
+	put := make(map[string]string)
+	var remove []string
+
+	for key, value := range new {
+		if v, ok := old[key]; !ok || v != value {
+			put[key] = value
+		}
+	}
+
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			remove = append(remove, key)
+		}
+	}
+
+	slices.Sort(remove)
+
+	return put, remove
+}
+
+func getParametersWriteOnlyValues(d *schema.ResourceData) (map[string]string, diag.Diagnostics) {
+	valueWO, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("parameters_wo"))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	values, err := expandParametersWriteOnly(valueWO)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return values, diags
+}
+
+// expandParametersWriteOnly decodes the JSON object of parameter names to values in parameters_wo.
+// Parameters whose value is null are ignored.
+func expandParametersWriteOnly(s string) (map[string]string, error) {
+	values := make(map[string]string)
+	if s == "" {
+		return values, nil
+	}
+
+	var m map[string]any
+	if err := tfjson.DecodeFromString(s, &m); err != nil {
+		return nil, fmt.Errorf("decoding parameters_wo: %w", err)
+	}
+
+	for key, v := range m {
+		if v == nil {
+			continue
+		}
+
+		if len(key) < 1 || len(key) > 2047 || strings.HasPrefix(key, "/") {
+			return nil, fmt.Errorf("parameters_wo: invalid parameter name %q: must be between 1 and 2047 characters and not start with '/'", key)
+		}
+
+		value, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("parameters_wo: value of parameter %q must be a string", key)
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+func checkParametersWriteOnlyKeys(values, valuesWO map[string]string) error {
+	for key := range valuesWO {
+		if _, ok := values[key]; ok {
+			return fmt.Errorf("parameter %q is set in both parameters and parameters_wo", key)
+		}
+	}
+
+	return nil
+}
+
+// putParameters puts the parameters under the path one at a time, retrying
+// when PutParameter is throttled.
+func putParameters(ctx context.Context, conn *ssm.Client, path string, values map[string]string, typ awstypes.ParameterType, keyID string, overwrite bool, timeout time.Duration) error {
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		name := path + key
+		input := ssm.PutParameterInput{
+			Name:      aws.String(name),
+			Overwrite: aws.Bool(overwrite),
+			Type:      typ,
+			Value:     aws.String(values[key]),
+		}
+
+		if keyID != "" && typ == awstypes.ParameterTypeSecureString {
+			input.KeyId = aws.String(keyID)
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func(ctx context.Context) (*ssm.PutParameterOutput, error) {
+			return conn.PutParameter(ctx, &input)
+		}, errCodeThrottlingException, errCodeTooManyUpdates)
+
+		if err != nil {
+			return fmt.Errorf("putting SSM Parameter (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteParameters deletes the parameters under the path in batches of 10,
+// the maximum supported by DeleteParameters.
+func deleteParameters(ctx context.Context, conn *ssm.Client, path string, keys []string, timeout time.Duration) error {
+	const (
+		batchSize = 10
+	)
+
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, path+key)
+	}
+	slices.Sort(names)
+
+	for chunk := range slices.Chunk(names, batchSize) {
+		input := ssm.DeleteParametersInput{
+			Names: chunk,
+		}
+
+		// Parameters that do not exist are returned as InvalidParameters and ignored.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func(ctx context.Context) (*ssm.DeleteParametersOutput, error) {
+			return conn.DeleteParameters(ctx, &input)
+		}, errCodeThrottlingException)
+
+		if err != nil {
+			return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(chunk, ", "), err)
+		}
+	}
+
+	return nil
+}
+
+// findParametersByPath returns the parameters under the path, recursively, keyed by
+// their name relative to the path.
+func findParametersByPath(ctx context.Context, conn *ssm.Client, path string) (map[string]awstypes.Parameter, error) {
+	input := ssm.GetParametersByPathInput{
+		Path:           aws.String(parametersQueryPath(path)),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	output := make(map[string]awstypes.Parameter)
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Parameters {
+			output[strings.TrimPrefix(aws.ToString(v.Name), path)] = v
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParametersDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new       map[string]string
+		expectedPut    map[string]string
+		expectedRemove []string
+	}{
+		"empty": {
+			expectedPut: map[string]string{},
+		},
+		"create": {
+			new: map[string]string{
+				"a": "1",
+				"b": "2",
+			},
+			expectedPut: map[string]string{
+				"a": "1",
+				"b": "2",
+			},
+		},
+		"unchanged": {
+			old: map[string]string{
+				"a": "1",
+			},
+			new: map[string]string{
+				"a": "1",
+			},
+			expectedPut: map[string]string{},
+		},
+		"update add and remove": {
+			old: map[string]string{
+				"a": "1",
+				"b": "2",
+				"d": "4",
+				"c": "3",
+			},
+			new: map[string]string{
+				"a": "1",
+				"b": "20",
+				"e": "5",
+			},
+			expectedPut: map[string]string{
+				"b": "20",
+				"e": "5",
+			},
+			expectedRemove: []string{"c", "d"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			put, remove := tfssm.ParametersDiff(testCase.old, testCase.new)
+
+			if !maps.Equal(put, testCase.expectedPut) {
+				t.Errorf("put = %v, want %v", put, testCase.expectedPut)
+			}
+			if !slices.Equal(remove, testCase.expectedRemove) {
+				t.Errorf("remove = %v, want %v", remove, testCase.expectedRemove)
+			}
+		})
+	}
+}
+
+func TestParametersQueryPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     string
+		expected string
+	}{
+		"root": {
+			path:     "/",
+			expected: "/",
+		},
+		"top level": {
+			path:     "/app/",
+			expected: "/app",
+		},
+		"nested": {
+			path:     "/app/prod/",
+			expected: "/app/prod",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfssm.ParametersQueryPath(testCase.path), testCase.expected; got != want {
+				t.Errorf("ParametersQueryPath(%q) = %q, want %q", testCase.path, got, want)
+			}
+		})
+	}
+}
+
+func TestExpandParametersWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      map[string]string
+		expectedError bool
+	}{
+		"empty": {
+			expected: map[string]string{},
+		},
+		"values": {
+			input: `{"password":"secret","nested/token":"abc"}`,
+			expected: map[string]string{
+				"password":     "secret",
+				"nested/token": "abc",
+			},
+		},
+		"null value": {
+			input: `{"password":"secret","token":null}`,
+			expected: map[string]string{
+				"password": "secret",
+			},
+		},
+		"non-string value": {
+			input:         `{"port":8080}`,
+			expectedError: true,
+		},
+		"absolute name": {
+			input:         `{"/password":"secret"}`,
+			expectedError: true,
+		},
+		"not an object": {
+			input:         `["secret"]`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfssm.ExpandParametersWriteOnly(testCase.input)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !maps.Equal(got, testCase.expected) {
+				t.Errorf("ExpandParametersWriteOnly(%s) = %v, want %v", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName, "1", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, map[string]string{
+						"one":        "1",
+						"nested/two": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPath, "/"+rName+"/"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.one", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.nested/two", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "String"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParametersConfig_updated(rName, "10", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, map[string]string{
+						"one":   "10",
+						"three": "3",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.one", "10"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.three", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName, "1", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, nil),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_secureString(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_secureString(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, map[string]string{
+						"secret": "s3cr3t",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "SecureString"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrKeyID},
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_writeOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_writeOnly(rName, "test", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, map[string]string{
+						"plain":  "value",
+						"secret": "test",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters_wo_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parameters_wo_keys.*", "secret"),
+					resource.TestCheckNoResourceAttr(resourceName, "parameters_wo"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_writeOnly(rName, "testUpdated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExist(ctx, resourceName, map[string]string{
+						"plain":  "value",
+						"secret": "testUpdated",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "parameters_wo_keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersExist(ctx context.Context, n string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("SSM Parameters %s not found", rs.Primary.ID)
+		}
+
+		for key, want := range expected {
+			v, ok := output[key]
+			if !ok {
+				return fmt.Errorf("SSM Parameter %s%s not found", rs.Primary.ID, key)
+			}
+
+			if got := aws.ToString(v.Value); got != want {
+				return fmt.Errorf("SSM Parameter %s%s value: got %q, want %q", rs.Primary.ID, key, got, want)
+			}
+		}
+
+		if expected != nil && len(output) != len(expected) {
+			return fmt.Errorf("SSM Parameters %s: got %d parameters, want %d", rs.Primary.ID, len(output), len(expected))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccParametersConfig_basic(rName, value1, value2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameters = {
+    "one"        = %[2]q
+    "nested/two" = %[3]q
+  }
+}
+`, rName, value1, value2)
+}
+
+func testAccParametersConfig_updated(rName, value1, value3 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameters = {
+    "one"   = %[2]q
+    "three" = %[3]q
+  }
+}
+`, rName, value1, value3)
+}
+
+func testAccParametersConfig_secureString(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_ssm_parameters" "test" {
+  path   = "/%[1]s/"
+  type   = "SecureString"
+  key_id = aws_kms_key.test.arn
+
+  parameters = {
+    "secret" = "s3cr3t"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_writeOnly(rName, value string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+  type = "SecureString"
+
+  parameters = {
+    "plain" = "value"
+  }
+
+  parameters_wo = jsonencode({
+    "secret" = %[2]q
+  })
+  parameters_wo_version = %[3]d
+}
+`, rName, value, version)
+}
@@ -183,6 +183,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				CustomImport: true,
 			},
 		},
+		{
+			Factory:  resourceParameters,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
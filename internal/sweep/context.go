@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKeyType int
+
+const (
+	regionContextKey contextKeyType = iota
+	resourceTypeContextKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +24,24 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+// WithResourceType returns a Context for sweeping resources of the specified type.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+	return v
+}
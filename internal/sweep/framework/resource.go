@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -46,6 +49,51 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// Describe returns the resource's ID together with any name, tags and creation time attributes set by the sweeper.
+func (sr *sweepResource) Describe(context.Context) describe.Description {
+	var description describe.Description
+
+	for _, attr := range sr.attributes {
+		switch attr.path {
+		case names.AttrID:
+			description.ID = attributeString(attr.value)
+		case names.AttrName:
+			description.Name = attributeString(attr.value)
+		case names.AttrTags:
+			switch v := attr.value.(type) {
+			case map[string]string:
+				description.Tags = v
+			case map[string]*string:
+				description.Tags = aws.ToStringMap(v)
+			}
+		default:
+			if slices.Contains(describe.CreationTimeAttributes, attr.path) {
+				if t, ok := describe.ParseTime(attr.value); ok {
+					description.CreationTime = t
+				}
+			}
+		}
+	}
+
+	// Resources identified by an attribute other than "id" are described by their first attribute.
+	if description.ID == "" && len(sr.attributes) > 0 {
+		description.ID = attributeString(sr.attributes[0].value)
+	}
+
+	return description
+}
+
+func attributeString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *string:
+		return aws.ToString(v)
+	}
+
+	return fmt.Sprint(v)
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 	if err != nil {
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package describe
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CreationTimeAttributes are the names of the attributes from which a resource's creation time is read.
+var CreationTimeAttributes = []string{
+	names.AttrCreateTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// Description describes a resource to be swept.
+// Fields are left empty if the value is not known.
+type Description struct {
+	CreationTime time.Time
+	ID           string
+	Name         string
+	Tags         map[string]string
+}
+
+// ParseTime parses a resource creation time attribute value.
+func ParseTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, !v.IsZero()
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return *v, !v.IsZero()
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		return t, err == nil
+	case *string:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return ParseTime(*v)
+	}
+
+	return time.Time{}, false
+}
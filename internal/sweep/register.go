@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+var registry = struct {
+	lock         sync.Mutex
+	orchestrated map[string]bool
+	semaphores   map[string]tfsync.Semaphore
+	sweepers     map[string]*resource.Sweeper
+}{
+	orchestrated: make(map[string]bool),
+	semaphores:   make(map[string]tfsync.Semaphore),
+	sweepers:     make(map[string]*resource.Sweeper),
+}
+
+// AddTestSweepers registers a sweeper with both the plugin-testing sweeper runner and
+// the provider's dependency-ordered parallel sweeper scheduler.
+// For a dry run, or when resource filters are configured, the sweeper is skipped unless it has been
+// declared to delete resources only through SweepOrchestrator, which applies the dry run and filters.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	f := s.F
+	s.F = func(region string) error {
+		if !orchestrated(name) {
+			selection, err := loadSelection()
+			if err != nil {
+				return err
+			}
+
+			if skip, err := selection.skipSweeper(region, name); skip || err != nil {
+				return err
+			}
+		}
+
+		return f(region)
+	}
+
+	resource.AddTestSweepers(name, s)
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	registry.sweepers[name] = s
+}
+
+// LimitConcurrency limits the number of the named sweepers that the parallel sweeper scheduler runs concurrently
+// to the capacity of the specified semaphore.
+// This can be used for services with low API quotas. Sweepers sharing a semaphore with a capacity of 0 are skipped.
+func LimitConcurrency(semaphore tfsync.Semaphore, names ...string) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	for _, name := range names {
+		registry.semaphores[name] = semaphore
+	}
+}
+
+// SweepsThroughOrchestrator declares that the named sweepers delete resources only by calling SweepOrchestrator.
+// Other sweepers are skipped for a dry run or when resource filters are configured.
+func SweepsThroughOrchestrator(names ...string) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	for _, name := range names {
+		registry.orchestrated[name] = true
+	}
+}
+
+func orchestrated(name string) bool {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	return registry.orchestrated[name]
+}
+
+// SweeperNames returns the sorted names of all registered sweepers.
+func SweeperNames() []string {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	return slices.Sorted(maps.Keys(registry.sweepers))
+}
+
+func registeredSweepers() (map[string]*resource.Sweeper, map[string]tfsync.Semaphore) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	return maps.Clone(registry.sweepers), maps.Clone(registry.semaphores)
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+var flagSweepParallelism = flag.Int("sweep-parallelism", 0, "Maximum number of Sweepers to run concurrently in each Region. Sweepers run serially if not set")
+
+// TestMain is a replacement for resource.TestMain that adds the -sweep-parallelism flag.
+// When -sweep-parallelism is set, all registered sweepers are ordered by their dependencies and independent
+// sweepers are run concurrently, up to the specified limit, in each Region.
+// Otherwise sweepers are run serially by the plugin-testing sweeper runner.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" || *flagSweepParallelism <= 0 {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	sweepers, semaphores := registeredSweepers()
+	sweepers, err := filterSweepers(flagValue("sweep-run"), sweepers)
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	scheduler := &sweeperScheduler{
+		allowFailures: allowFailures,
+		parallelism:   *flagSweepParallelism,
+		semaphores:    semaphores,
+		sweepers:      sweepers,
+	}
+
+	if _, err := scheduler.runAll(strings.Split(regions, ",")); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// flagValue returns the value of a flag defined by the plugin-testing sweeper runner.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated, case-insensitive filters,
+// together with all of their dependencies.
+// This matches the filtering performed by the plugin-testing sweeper runner.
+func filterSweepers(filter string, sweepers map[string]*resource.Sweeper) (map[string]*resource.Sweeper, error) {
+	filters := strings.Split(strings.ToLower(filter), ",")
+	if len(filters) == 1 && filters[0] == "" {
+		return sweepers, nil
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string) error
+	add = func(name string) error {
+		if _, ok := result[name]; ok {
+			return nil
+		}
+
+		sweeper, ok := sweepers[name]
+		if !ok {
+			return fmt.Errorf("sweeper (%s) not found", name)
+		}
+		result[name] = sweeper
+
+		for _, dependency := range sweeper.Dependencies {
+			if err := add(dependency); err != nil {
+				return fmt.Errorf("sweeper (%s) dependency: %w", name, err)
+			}
+		}
+
+		return nil
+	}
+
+	for name := range sweepers {
+		if !slices.ContainsFunc(filters, func(f string) bool { return strings.Contains(strings.ToLower(name), f) }) {
+			continue
+		}
+
+		if err := add(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// sweeperScheduler runs sweepers in dependency order, running independent sweepers concurrently.
+type sweeperScheduler struct {
+	allowFailures bool
+	parallelism   int
+	semaphores    map[string]tfsync.Semaphore
+	sweepers      map[string]*resource.Sweeper
+}
+
+// graph returns the sweepers' dependency graph.
+func (s *sweeperScheduler) graph() (*depgraph.Graph, error) {
+	graph := depgraph.New()
+
+	for name := range s.sweepers {
+		graph.AddNode(name)
+	}
+
+	for name, sweeper := range s.sweepers {
+		for _, dependency := range sweeper.Dependencies {
+			if err := graph.AddDependency(name, dependency); err != nil {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+		}
+	}
+
+	if _, err := graph.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// runAll runs the sweepers in each Region in turn and returns each sweeper's result by Region.
+func (s *sweeperScheduler) runAll(regions []string) (map[string]map[string]error, error) {
+	results := make(map[string]map[string]error)
+	var failed bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s) with parallelism %d", region, s.parallelism)
+
+		regionResults, err := s.run(region)
+		results[region] = regionResults
+		if err != nil {
+			return results, fmt.Errorf("region (%s): %w", region, err)
+		}
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		for _, name := range slices.Sorted(maps.Keys(regionResults)) {
+			if err := regionResults[name]; err != nil {
+				failed = true
+				log.Printf("[ERROR] Sweeper (%s) in region (%s) failed: %s", name, region, err)
+			}
+		}
+	}
+
+	if failed {
+		return results, errors.New("at least one sweeper failed")
+	}
+
+	return results, nil
+}
+
+// run runs the sweepers in a single Region.
+// A sweeper is started once all of its dependencies have completed, whether or not they succeeded.
+// Unless failures are allowed, no further sweepers are started after the first failure.
+func (s *sweeperScheduler) run(region string) (map[string]error, error) {
+	graph, err := s.graph()
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		name string
+		err  error
+	}
+
+	results := make(map[string]error)
+	remaining := make(map[string]int) // Sweeper name -> number of incomplete dependencies.
+	var ready []string
+
+	for name := range s.sweepers {
+		dependencies, err := graph.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if remaining[name] = len(dependencies); remaining[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+	slices.Sort(ready)
+
+	done := make(chan result, len(s.sweepers))
+	var running int
+	var failure error
+
+	for {
+		for failure == nil && running < s.parallelism && len(ready) > 0 {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func() {
+				done <- result{name: name, err: s.runSweeper(region, name)}
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-done
+		running--
+		results[r.name] = r.err
+
+		if r.err != nil && !s.allowFailures && failure == nil {
+			failure = fmt.Errorf("sweeper (%s) failed: %w", r.name, r.err)
+		}
+
+		dependents, err := graph.DirectDependentsOf(r.name)
+		if err != nil {
+			return results, err
+		}
+		slices.Sort(dependents)
+
+		for _, dependent := range dependents {
+			if remaining[dependent]--; remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return results, failure
+}
+
+// runSweeper runs a single sweeper, waiting for capacity on any semaphore limiting its concurrency.
+func (s *sweeperScheduler) runSweeper(region, name string) error {
+	if semaphore, ok := s.semaphores[name]; ok {
+		if cap(semaphore) == 0 {
+			log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): concurrency set to 0", name, region)
+			return nil
+		}
+
+		semaphore.Wait()
+		defer semaphore.Notify()
+	}
+
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+	start := time.Now()
+	err := s.sweepers[name].F(region)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+	return err
+}
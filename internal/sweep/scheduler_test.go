@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+// sweeperRecorder records the order in which test sweepers complete and their maximum concurrency.
+type sweeperRecorder struct {
+	completed      []string
+	lock           sync.Mutex
+	maxConcurrency int
+	running        int
+}
+
+func (r *sweeperRecorder) sweeper(name string, err error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name: name,
+		F: func(string) error {
+			r.lock.Lock()
+			r.running++
+			r.maxConcurrency = max(r.maxConcurrency, r.running)
+			r.lock.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			r.lock.Lock()
+			r.running--
+			r.completed = append(r.completed, name)
+			r.lock.Unlock()
+
+			return err
+		},
+		Dependencies: dependencies,
+	}
+}
+
+func TestSweeperScheduler(t *testing.T) {
+	t.Parallel()
+
+	errSweep := errors.New("sweep failed")
+
+	testCases := map[string]struct {
+		sweepers          func(*sweeperRecorder) map[string]*resource.Sweeper
+		allowFailures     bool
+		parallelism       int
+		semaphores        map[string]tfsync.Semaphore
+		expectError       bool
+		expectedCompleted []string
+		maxConcurrency    int
+		unordered         bool // Sweepers may complete in any order.
+	}{
+		"serial": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil, "b"),
+					"b": r.sweeper("b", nil, "c"),
+					"c": r.sweeper("c", nil),
+				}
+			},
+			parallelism:       4,
+			expectedCompleted: []string{"c", "b", "a"},
+			maxConcurrency:    1,
+		},
+		"independent": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil),
+					"b": r.sweeper("b", nil),
+					"c": r.sweeper("c", nil),
+					"d": r.sweeper("d", nil),
+				}
+			},
+			parallelism:       2,
+			expectedCompleted: []string{"a", "b", "c", "d"},
+			maxConcurrency:    2,
+			unordered:         true,
+		},
+		"semaphore": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil),
+					"b": r.sweeper("b", nil),
+					"c": r.sweeper("c", nil),
+				}
+			},
+			parallelism: 4,
+			semaphores: func() map[string]tfsync.Semaphore {
+				semaphore := make(tfsync.Semaphore, 1)
+				return map[string]tfsync.Semaphore{"a": semaphore, "b": semaphore, "c": semaphore}
+			}(),
+			expectedCompleted: []string{"a", "b", "c"},
+			maxConcurrency:    1,
+			unordered:         true,
+		},
+		"semaphore zero capacity": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil),
+					"b": r.sweeper("b", nil),
+				}
+			},
+			parallelism:       4,
+			semaphores:        map[string]tfsync.Semaphore{"a": make(tfsync.Semaphore)},
+			expectedCompleted: []string{"b"},
+			maxConcurrency:    1,
+		},
+		"failure": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil, "b"),
+					"b": r.sweeper("b", errSweep),
+				}
+			},
+			parallelism:       4,
+			expectError:       true,
+			expectedCompleted: []string{"b"},
+			maxConcurrency:    1,
+		},
+		"failure allowed": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil, "b"),
+					"b": r.sweeper("b", errSweep),
+				}
+			},
+			allowFailures:     true,
+			parallelism:       4,
+			expectError:       true,
+			expectedCompleted: []string{"b", "a"},
+			maxConcurrency:    1,
+		},
+		"missing dependency": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil, "b"),
+				}
+			},
+			parallelism: 4,
+			expectError: true,
+		},
+		"cycle": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"a": r.sweeper("a", nil, "b"),
+					"b": r.sweeper("b", nil, "a"),
+				}
+			},
+			parallelism: 4,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := &sweeperRecorder{}
+			scheduler := &sweeperScheduler{
+				allowFailures: testCase.allowFailures,
+				parallelism:   testCase.parallelism,
+				semaphores:    testCase.semaphores,
+				sweepers:      testCase.sweepers(recorder),
+			}
+
+			_, err := scheduler.runAll([]string{"us-west-2"}) //lintignore:AWSAT003
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+
+			completed := recorder.completed
+			if testCase.unordered {
+				slices.Sort(completed)
+			}
+			if diff := cmp.Diff(completed, testCase.expectedCompleted); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := recorder.maxConcurrency, testCase.maxConcurrency; got != want {
+				t.Errorf("maximum concurrency = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	t.Parallel()
+
+	recorder := &sweeperRecorder{}
+	names := []string{"aws_test_limit_concurrency_a", "aws_test_limit_concurrency_b", "aws_test_limit_concurrency_c"}
+	for _, name := range names {
+		AddTestSweepers(name, recorder.sweeper(name, nil))
+	}
+	SweepsThroughOrchestrator(names...)
+	LimitConcurrency(make(tfsync.Semaphore, 1), names...)
+
+	sweepers, semaphores := registeredSweepers()
+	sweepers, err := filterSweepers("aws_test_limit_concurrency_", sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	scheduler := &sweeperScheduler{
+		parallelism: 4,
+		semaphores:  semaphores,
+		sweepers:    sweepers,
+	}
+
+	if _, err := scheduler.runAll([]string{"us-west-2"}); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	completed := recorder.completed
+	slices.Sort(completed)
+	if diff := cmp.Diff(completed, names); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := recorder.maxConcurrency, 1; got != want {
+		t.Errorf("maximum concurrency = %d, want %d", got, want)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_example_thing":       {Name: "aws_example_thing", Dependencies: []string{"aws_example_other_thing"}},
+		"aws_example_other_thing": {Name: "aws_example_other_thing"},
+		"aws_example_widget":      {Name: "aws_example_widget"},
+		"aws_example_gadget":      {Name: "aws_example_gadget", Dependencies: []string{"aws_example_missing"}},
+	}
+
+	testCases := map[string]struct {
+		filter        string
+		expectError   bool
+		expectedNames []string
+	}{
+		"no filter": {
+			expectedNames: []string{"aws_example_gadget", "aws_example_other_thing", "aws_example_thing", "aws_example_widget"},
+		},
+		"dependencies": {
+			filter:        "aws_example_thing",
+			expectedNames: []string{"aws_example_other_thing", "aws_example_thing"},
+		},
+		"case insensitive": {
+			filter:        "WIDGET,other",
+			expectedNames: []string{"aws_example_other_thing", "aws_example_widget"},
+		},
+		"missing dependency": {
+			filter:      "gadget",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filterSweepers(testCase.filter, sweepers)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(slices.Sorted(maps.Keys(got)), testCase.expectedNames); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
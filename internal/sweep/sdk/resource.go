@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -33,6 +36,41 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// Describe returns the resource's ID together with any name, tags and creation time set by the sweeper.
+func (sr *sweepResource) Describe(context.Context) describe.Description {
+	description := describe.Description{
+		ID: sr.d.Id(),
+	}
+
+	schema := sr.resource.SchemaMap()
+
+	if _, ok := schema[names.AttrName]; ok {
+		if v, ok := sr.d.GetOk(names.AttrName); ok {
+			description.Name, _ = v.(string)
+		}
+	}
+
+	if _, ok := schema[names.AttrTags]; ok {
+		if v, ok := sr.d.GetOk(names.AttrTags); ok {
+			description.Tags = flex.ExpandStringValueMap(v.(map[string]any))
+		}
+	}
+
+	for _, k := range describe.CreationTimeAttributes {
+		if _, ok := schema[k]; !ok {
+			continue
+		}
+		if v, ok := sr.d.GetOk(k); ok {
+			if t, ok := describe.ParseTime(v); ok {
+				description.CreationTime = t
+				break
+			}
+		}
+	}
+
+	return description
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
+)
+
+// Description describes a resource to be swept.
+type Description = describe.Description
+
+// Describer is implemented by Sweepables that can describe the resource that they delete.
+// Descriptions are used to select the resources to sweep and in sweeper reports.
+type Describer interface {
+	Describe(context.Context) Description
+}
+
+const (
+	sweepActionDelete = "delete"
+	sweepActionSkip   = "skip"
+)
+
+// selection selects the resources to sweep, as configured by environment variables.
+type selection struct {
+	dryRun       bool
+	minAge       time.Duration
+	namePrefixes []string
+	report       *sweepReport
+	tags         map[string]*string // Tag key -> value. A nil value matches any value.
+}
+
+var loadSelection = sync.OnceValues(func() (*selection, error) {
+	return newSelection(os.Getenv)
+})
+
+func newSelection(getenv func(string) string) (*selection, error) {
+	s := &selection{}
+
+	if v := getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		s.dryRun = dryRun
+	}
+
+	if v := getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		s.minAge = minAge
+	}
+
+	if v := getenv(envvar.SweepNamePrefixes); v != "" {
+		for prefix := range strings.SplitSeq(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				s.namePrefixes = append(s.namePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := getenv(envvar.SweepTags); v != "" {
+		s.tags = make(map[string]*string)
+		for tag := range strings.SplitSeq(v, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(tag), "=")
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: invalid tag %q", envvar.SweepTags, tag)
+			}
+			if ok {
+				s.tags[key] = &value
+			} else {
+				s.tags[key] = nil
+			}
+		}
+	}
+
+	if path := getenv(envvar.SweepReportFile); path != "" {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("opening sweeper report file (%s): %w", path, err)
+		}
+		s.report = &sweepReport{w: f}
+	} else if s.dryRun {
+		s.report = &sweepReport{w: os.Stdout}
+	}
+
+	return s, nil
+}
+
+// filtered returns whether any filters are configured.
+func (s *selection) filtered() bool {
+	return s.minAge > 0 || len(s.namePrefixes) > 0 || len(s.tags) > 0
+}
+
+// selects returns whether the described resource is selected for sweeping and, if not, why not.
+// Resources are not selected if a filter is configured and the resource's value for that filter is unknown.
+func (s *selection) selects(d Description, now time.Time) (bool, string) {
+	if len(s.namePrefixes) > 0 {
+		if d.Name == "" {
+			return false, "name unknown"
+		}
+
+		var ok bool
+		for _, prefix := range s.namePrefixes {
+			if strings.HasPrefix(d.Name, prefix) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false, "name does not match"
+		}
+	}
+
+	for key, value := range s.tags {
+		if d.Tags == nil {
+			return false, "tags unknown"
+		}
+
+		v, ok := d.Tags[key]
+		if !ok || (value != nil && v != *value) {
+			return false, fmt.Sprintf("tag %q does not match", key)
+		}
+	}
+
+	if s.minAge > 0 {
+		if d.CreationTime.IsZero() {
+			return false, "creation time unknown"
+		}
+
+		if now.Sub(d.CreationTime) < s.minAge {
+			return false, fmt.Sprintf("created less than %s ago", s.minAge)
+		}
+	}
+
+	return true, ""
+}
+
+// apply returns the sweepables selected for deletion, writing a report record for each sweepable.
+// No sweepables are returned for a dry run.
+func (s *selection) apply(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	if !s.filtered() && s.report == nil {
+		return sweepables, nil
+	}
+
+	now := time.Now()
+	region, resourceType := regionFromContext(ctx), resourceTypeFromContext(ctx)
+	selected := make([]Sweepable, 0, len(sweepables))
+
+	for _, sweepable := range sweepables {
+		var d Description
+		var ok bool
+		var reason string
+
+		if v, isDescriber := sweepable.(Describer); isDescriber {
+			d = v.Describe(ctx)
+			ok, reason = s.selects(d, now)
+		} else {
+			ok = !s.filtered()
+			if !ok {
+				reason = "resource cannot be described"
+			}
+		}
+
+		record := sweepReportRecord{
+			Action:       sweepActionDelete,
+			DryRun:       s.dryRun,
+			ID:           d.ID,
+			Name:         d.Name,
+			Reason:       reason,
+			Region:       region,
+			Tags:         d.Tags,
+			ResourceType: resourceType,
+		}
+		if !d.CreationTime.IsZero() {
+			record.CreationTime = &d.CreationTime
+		}
+		if !ok {
+			record.Action = sweepActionSkip
+		}
+
+		if s.report != nil {
+			if err := s.report.write(record); err != nil {
+				return nil, fmt.Errorf("writing sweeper report: %w", err)
+			}
+		}
+
+		if !ok {
+			tflog.Debug(ctx, "Skipping resource", map[string]any{
+				"id":     d.ID,
+				"reason": reason,
+			})
+			continue
+		}
+
+		if !s.dryRun {
+			selected = append(selected, sweepable)
+		}
+	}
+
+	return selected, nil
+}
+
+// skipSweeper returns whether a sweeper that does not delete resources through SweepOrchestrator is skipped,
+// writing a report record if so.
+// Such sweepers are skipped for a dry run or when filters are configured, as neither can be applied to them.
+func (s *selection) skipSweeper(region, name string) (bool, error) {
+	if !s.dryRun && !s.filtered() {
+		return false, nil
+	}
+
+	const reason = "sweeper does not delete through the orchestrator"
+
+	log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, reason)
+
+	if s.report != nil {
+		record := sweepReportRecord{
+			Action:       sweepActionSkip,
+			DryRun:       s.dryRun,
+			Reason:       reason,
+			Region:       region,
+			ResourceType: name,
+		}
+		if err := s.report.write(record); err != nil {
+			return true, fmt.Errorf("writing sweeper report: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+type sweepReportRecord struct {
+	Action       string            `json:"action"`
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+	DryRun       bool              `json:"dry_run"`
+	ID           string            `json:"id,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	Reason       string            `json:"reason,omitempty"`
+	Region       string            `json:"region,omitempty"`
+	ResourceType string            `json:"type,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// sweepReport writes one JSON object per line for each resource considered by a sweeper.
+// Sweepers run concurrently, so writes are serialized.
+type sweepReport struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func (r *sweepReport) write(record sweepReportRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	_, err = r.w.Write(append(b, '\n'))
+
+	return err
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	description Description
+}
+
+func (s *testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (s *testSweepable) Describe(context.Context) Description {
+	return s.description
+}
+
+type testUndescribedSweepable struct{}
+
+func (testUndescribedSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func TestSelectionSelects(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := map[string]struct {
+		env            map[string]string
+		description    Description
+		expectSelected bool
+		expectedReason string
+	}{
+		"no filters": {
+			description:    Description{ID: "id-1"},
+			expectSelected: true,
+		},
+		"name prefix": {
+			env: map[string]string{
+				envvar.SweepNamePrefixes: "tf-acc-test-, tf-test-",
+			},
+			description:    Description{Name: "tf-test-1"},
+			expectSelected: true,
+		},
+		"name prefix no match": {
+			env: map[string]string{
+				envvar.SweepNamePrefixes: "tf-acc-test-",
+			},
+			description:    Description{Name: "production"},
+			expectedReason: "name does not match",
+		},
+		"name unknown": {
+			env: map[string]string{
+				envvar.SweepNamePrefixes: "tf-acc-test-",
+			},
+			description:    Description{ID: "id-1"},
+			expectedReason: "name unknown",
+		},
+		"tags": {
+			env: map[string]string{
+				envvar.SweepTags: "Owner=ci,Ephemeral",
+			},
+			description:    Description{Tags: map[string]string{"Owner": "ci", "Ephemeral": "yes", "Other": "x"}},
+			expectSelected: true,
+		},
+		"tag value no match": {
+			env: map[string]string{
+				envvar.SweepTags: "Owner=ci",
+			},
+			description:    Description{Tags: map[string]string{"Owner": "alice"}},
+			expectedReason: `tag "Owner" does not match`,
+		},
+		"tags unknown": {
+			env: map[string]string{
+				envvar.SweepTags: "Owner=ci",
+			},
+			description:    Description{ID: "id-1"},
+			expectedReason: "tags unknown",
+		},
+		"old enough": {
+			env: map[string]string{
+				envvar.SweepMinAge: "2h",
+			},
+			description:    Description{CreationTime: now.Add(-3 * time.Hour)},
+			expectSelected: true,
+		},
+		"too new": {
+			env: map[string]string{
+				envvar.SweepMinAge: "2h",
+			},
+			description:    Description{CreationTime: now.Add(-1 * time.Hour)},
+			expectedReason: "created less than 2h0m0s ago",
+		},
+		"creation time unknown": {
+			env: map[string]string{
+				envvar.SweepMinAge: "2h",
+			},
+			description:    Description{ID: "id-1"},
+			expectedReason: "creation time unknown",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := newSelection(func(k string) string { return testCase.env[k] })
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			selected, reason := s.selects(testCase.description, now)
+
+			if got, want := selected, testCase.expectSelected; got != want {
+				t.Errorf("selected = %t, want %t", got, want)
+			}
+			if got, want := reason, testCase.expectedReason; got != want {
+				t.Errorf("reason = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNewSelectionInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]string{
+		"dry run": {envvar.SweepDryRun: "maybe"},
+		"min age": {envvar.SweepMinAge: "2 hours"},
+		"tags":    {envvar.SweepTags: "=value"},
+	}
+
+	for name, env := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := newSelection(func(k string) string { return env[k] }); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestSelectionApply(t *testing.T) {
+	t.Parallel()
+
+	sweepables := []Sweepable{
+		&testSweepable{description: Description{ID: "id-1", Name: "tf-acc-test-1"}},
+		&testSweepable{description: Description{ID: "id-2", Name: "production"}},
+		testUndescribedSweepable{},
+	}
+
+	ctx := WithResourceType(Context("us-west-2"), "aws_example_thing") //lintignore:AWSAT003
+
+	testCases := map[string]struct {
+		dryRun          bool
+		expectedIDs     []string
+		expectedRecords []sweepReportRecord
+	}{
+		"delete": {
+			expectedIDs: []string{"id-1"},
+			expectedRecords: []sweepReportRecord{
+				{Action: sweepActionDelete, ID: "id-1", Name: "tf-acc-test-1", Region: "us-west-2", ResourceType: "aws_example_thing"},                           //lintignore:AWSAT003
+				{Action: sweepActionSkip, ID: "id-2", Name: "production", Reason: "name does not match", Region: "us-west-2", ResourceType: "aws_example_thing"}, //lintignore:AWSAT003
+				{Action: sweepActionSkip, Reason: "resource cannot be described", Region: "us-west-2", ResourceType: "aws_example_thing"},                        //lintignore:AWSAT003
+			},
+		},
+		"dry run": {
+			dryRun: true,
+			expectedRecords: []sweepReportRecord{
+				{Action: sweepActionDelete, DryRun: true, ID: "id-1", Name: "tf-acc-test-1", Region: "us-west-2", ResourceType: "aws_example_thing"},                           //lintignore:AWSAT003
+				{Action: sweepActionSkip, DryRun: true, ID: "id-2", Name: "production", Reason: "name does not match", Region: "us-west-2", ResourceType: "aws_example_thing"}, //lintignore:AWSAT003
+				{Action: sweepActionSkip, DryRun: true, Reason: "resource cannot be described", Region: "us-west-2", ResourceType: "aws_example_thing"},                        //lintignore:AWSAT003
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			s := &selection{
+				dryRun:       testCase.dryRun,
+				namePrefixes: []string{"tf-acc-test-"},
+				report:       &sweepReport{w: &buf},
+			}
+
+			got, err := s.apply(ctx, sweepables)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotIDs []string
+			for _, v := range got {
+				gotIDs = append(gotIDs, v.(Describer).Describe(ctx).ID)
+			}
+			if diff := cmp.Diff(gotIDs, testCase.expectedIDs); diff != "" {
+				t.Errorf("unexpected selection diff (+wanted, -got): %s", diff)
+			}
+
+			var gotRecords []sweepReportRecord
+			for line := range strings.Lines(buf.String()) {
+				var record sweepReportRecord
+				if err := json.Unmarshal([]byte(line), &record); err != nil {
+					t.Fatalf("unmarshaling report record: %s", err)
+				}
+				gotRecords = append(gotRecords, record)
+			}
+			if diff := cmp.Diff(gotRecords, testCase.expectedRecords); diff != "" {
+				t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectionSkipSweeper(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		selection       *selection
+		expectSkip      bool
+		expectedRecords []sweepReportRecord
+	}{
+		"unfiltered": {
+			selection: &selection{},
+		},
+		"dry run": {
+			selection:  &selection{dryRun: true},
+			expectSkip: true,
+			expectedRecords: []sweepReportRecord{
+				{Action: sweepActionSkip, DryRun: true, Reason: "sweeper does not delete through the orchestrator", Region: "us-west-2", ResourceType: "aws_example_thing"}, //lintignore:AWSAT003
+			},
+		},
+		"filtered": {
+			selection:  &selection{minAge: time.Hour},
+			expectSkip: true,
+			expectedRecords: []sweepReportRecord{
+				{Action: sweepActionSkip, Reason: "sweeper does not delete through the orchestrator", Region: "us-west-2", ResourceType: "aws_example_thing"}, //lintignore:AWSAT003
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			testCase.selection.report = &sweepReport{w: &buf}
+
+			skip, err := testCase.selection.skipSweeper("us-west-2", "aws_example_thing") //lintignore:AWSAT003
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if skip != testCase.expectSkip {
+				t.Errorf("expected skip %t, got %t", testCase.expectSkip, skip)
+			}
+
+			var gotRecords []sweepReportRecord
+			for line := range strings.Lines(buf.String()) {
+				var record sweepReportRecord
+				if err := json.Unmarshal([]byte(line), &record); err != nil {
+					t.Fatalf("unmarshaling report record: %s", err)
+				}
+				gotRecords = append(gotRecords, record)
+			}
+			if diff := cmp.Diff(gotRecords, testCase.expectedRecords); diff != "" {
+				t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -44,6 +44,13 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourcePermissions represents the IAM actions required by a resource's lifecycle operations.
+type ServicePackageResourcePermissions struct {
+	Create []string
+	Update []string
+	Delete []string
+}
+
 // ServicePackageAction represents a Terraform Plugin Framework action
 // implemented by a service package.
 type ServicePackageAction struct {
@@ -75,13 +82,14 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory     func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName    string
+	Name        string
+	Tags        unique.Handle[ServicePackageResourceTags]
+	Region      unique.Handle[ServicePackageResourceRegion]
+	Identity    Identity
+	Import      FrameworkImport
+	Permissions ServicePackageResourcePermissions
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -106,13 +114,14 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory     func() *schema.Resource
+	TypeName    string
+	Name        string
+	Tags        unique.Handle[ServicePackageResourceTags]
+	Region      unique.Handle[ServicePackageResourceRegion]
+	Identity    Identity
+	Import      SDKv2Import
+	Permissions ServicePackageResourcePermissions
 }
 
 type ListResourceForSDK interface {
@@ -151,6 +151,7 @@ const (
 	AttrPropagateTags              = "propagate_tags"
 	AttrProperties                 = "properties"
 	AttrProtocol                   = "protocol"
+	AttrProviderAccount            = "provider_account"
 	AttrProviderName               = "provider_name"
 	AttrPublicKey                  = "public_key"
 	AttrPubliclyAccessible         = "publicly_accessible"
@@ -163,6 +163,7 @@ func ConstOrQuote(constant string) string {
 		"propagate_tags":                "AttrPropagateTags",
 		"properties":                    "AttrProperties",
 		"protocol":                      "AttrProtocol",
+		"provider_account":              "AttrProviderAccount",
 		"provider_name":                 "AttrProviderName",
 		"public_key":                    "AttrPublicKey",
 		"publicly_accessible":           "AttrPubliclyAccessible",
@@ -8,16 +8,25 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io"
 	"os"
 	"path"
+	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/sdksource"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/testmigrate"
 )
 
 var (
@@ -60,7 +69,7 @@ func main() {
 	p, err := sdkv2.NewProvider(context.Background())
 
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err)
 	}
 
 	if v := *dataSourceType; v != "" {
@@ -86,8 +95,25 @@ func main() {
 		migrator.TFTypeName = v
 	}
 
+	if !migrator.IsDataSource {
+		// The generated file is placed alongside the Plugin SDK resource's source.
+		source, err := sdksource.Analyze(path.Dir(outputFilename), migrator.TFTypeName)
+
+		if err != nil {
+			g.Warnf("analyzing Terraform %s source, CRUD handlers will not be migrated: %s", migrator.TFTypeName, err)
+		} else {
+			migrator.Source = source
+		}
+	}
+
 	if err := migrator.migrate(outputFilename); err != nil {
-		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
+		g.Fatalf("error migrating Terraform %s schema: %s", migrator.TFTypeName, err)
+	}
+
+	if migrator.Source != nil {
+		if err := migrator.migrateTests(strings.TrimSuffix(outputFilename, ".go") + "_test.go"); err != nil {
+			g.Fatalf("error migrating Terraform %s acceptance tests: %s", migrator.TFTypeName, err)
+		}
 	}
 }
 
@@ -97,6 +123,7 @@ type migrator struct {
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	Source       *sdksource.Resource // Plugin SDK resource's source. nil for data sources or if the source could not be analyzed.
 	Template     string
 	TFTypeName   string
 }
@@ -145,23 +172,67 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
+		DefaultCreateTimeout:         durationExpr(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:           durationExpr(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:         durationExpr(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:         durationExpr(emitter.DefaultDeleteTimeout),
 		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasRegion:                    emitter.HasRegion,
+		HasTags:                      emitter.HasTopLevelTagsMap,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
+		NestedModels:                 emitter.NestedModels,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if source := m.Source; source != nil {
+		templateData.Annotations = source.Annotations
+		templateData.ClientMethod = source.ClientMethod
+		templateData.Create = source.Create
+		templateData.Read = source.Read
+		templateData.Update = source.Update
+		templateData.Delete = source.Delete
+		templateData.SDKPackage = source.SDKPackage
+
+		if v := source.SDKPath; v != "" {
+			sdkImport := goImport{
+				Path: v,
+			}
+			if path.Base(v) != source.SDKPackage {
+				sdkImport.Alias = source.SDKPackage
+			}
+			templateData.GoImports = append(templateData.GoImports, sdkImport)
+		}
+
+		humanName := source.Name
+		if humanName == "" {
+			humanName = m.Name
+		}
+		if v, err := names.HumanFriendly(m.PackageName); err == nil {
+			humanName = v + " " + humanName
+		}
+		templateData.HumanName = humanName
+
+		switch {
+		case slices.ContainsFunc(source.Annotations, isIdentityAnnotation):
+			templateData.ImportByIdentity = true
+		case source.Importer == "schema.ImportStatePassthroughContext":
+			templateData.ImportByID = true
+		}
+
+		for _, v := range []sdksource.Operation{source.Create, source.Read, source.Update, source.Delete} {
+			if len(v.APICalls) > 0 || len(v.Finders) > 0 || len(v.Waiters) > 0 {
+				templateData.EmitResourceCRUD = true
+			}
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -181,10 +252,189 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// migrateTests rewrites the Plugin SDK resource's acceptance tests into the specified output file.
+func (m *migrator) migrateTests(outputFilename string) error {
+	inputFilename := strings.TrimSuffix(m.Source.Filename, ".go") + "_test.go"
+
+	src, err := os.ReadFile(inputFilename)
+
+	if os.IsNotExist(err) {
+		m.Generator.Warnf("no acceptance tests found in %[1]q", inputFilename)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", inputFilename, err)
+	}
+
+	m.infof("migrating %[1]q into %[2]q", inputFilename, outputFilename)
+
+	constants, err := testConstants(path.Dir(outputFilename))
+
+	if err != nil {
+		return err
+	}
+
+	out, err := testmigrate.Rewrite(src, testmigrate.Options{
+		Constants: constants,
+		KindOf: func(path []string) testmigrate.Kind {
+			return kindOf(m.Resource.SchemaMap(), path)
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d := m.Generator.NewGoFileDestination(outputFilename)
+
+	if err := d.BufferBytes(out); err != nil {
+		return err
+	}
+
+	return d.Write()
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
 
+// testConstants returns the values of the names and acctest package constants used in acceptance tests.
+// The repository root is found by walking up from dir.
+func testConstants(dir string) (map[string]string, error) {
+	root, err := filepath.Abs(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(root, "names", "attr_consts_gen.go")); err == nil {
+			break
+		}
+
+		parent := filepath.Dir(root)
+
+		if parent == root {
+			return nil, fmt.Errorf("repository root not found from %s", dir)
+		}
+
+		root = parent
+	}
+
+	constants := make(map[string]string)
+
+	for _, v := range []struct {
+		filename, packageName string
+	}{
+		{filepath.Join(root, "names", "attr_consts_gen.go"), "names"},
+		{filepath.Join(root, "internal", "acctest", "consts_gen.go"), "acctest"},
+	} {
+		file, err := parser.ParseFile(token.NewFileSet(), v.filename, nil, 0)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", v.filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+
+				for i, name := range valueSpec.Names {
+					if i >= len(valueSpec.Values) {
+						continue
+					}
+
+					if lit, ok := valueSpec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						if value, err := strconv.Unquote(lit.Value); err == nil {
+							constants[v.packageName+"."+name.Name] = value
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return constants, nil
+}
+
+// kindOf returns the kind of the attribute or block at the specified path in a Plugin SDK schema.
+func kindOf(s map[string]*schema.Schema, path []string) testmigrate.Kind {
+	property, ok := s[path[0]]
+
+	if !ok {
+		return testmigrate.KindUnknown
+	}
+
+	if len(path) > 1 {
+		if v, ok := property.Elem.(*schema.Resource); ok {
+			return kindOf(v.SchemaMap(), path[1:])
+		}
+
+		return testmigrate.KindUnknown
+	}
+
+	switch property.Type {
+	case schema.TypeBool:
+		return testmigrate.KindBool
+	case schema.TypeFloat:
+		return testmigrate.KindFloat64
+	case schema.TypeInt:
+		return testmigrate.KindInt64
+	case schema.TypeString:
+		return testmigrate.KindString
+	case schema.TypeList:
+		return testmigrate.KindList
+	case schema.TypeMap:
+		return testmigrate.KindMap
+	case schema.TypeSet:
+		return testmigrate.KindSet
+	}
+
+	return testmigrate.KindUnknown
+}
+
+// isIdentityAnnotation returns whether or not the specified resource annotation declares a resource identity.
+func isIdentityAnnotation(annotation string) bool {
+	for _, v := range []string{"@ArnIdentity", "@CustomInherentRegionIdentity", "@IdentityAttribute", "@SingletonIdentity"} {
+		if annotation == v || strings.HasPrefix(annotation, v+"(") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// durationExpr returns a human-friendly Go expression for the specified duration in nanoseconds,
+// or an empty string if the duration is not positive.
+func durationExpr(d int64) string {
+	if d <= 0 {
+		return ""
+	}
+
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+	} {
+		if time.Duration(d)%v.unit == 0 {
+			return fmt.Sprintf("%d * %s", time.Duration(d)/v.unit, v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 type emitter struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -194,14 +444,17 @@ type emitter struct {
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
 	GoImports                     []goImport
+	HasRegion                     bool
 	HasTimeouts                   bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	NestedModels                  []string // Nested block model type declarations.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Writer for the fields of the model of the schema or block being emitted.
+	nestedModelNames              []string
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -216,6 +469,12 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		}
 	}
 
+	// The top-level "region" attribute injected by the provider is added to Plugin Framework schemas by the provider too.
+	if v, ok := resource.Schema[names.AttrRegion]; ok && v.Description == names.ResourceTopLevelRegionAttributeDescription {
+		e.HasRegion = true
+		delete(resource.Schema, names.AttrRegion)
+	}
+
 	if v := resource.Timeouts; v != nil {
 		e.HasTimeouts = true
 
@@ -294,22 +553,18 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -357,6 +612,29 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
+	// Special handling for 'tags' and 'tags_all'.
+	if isTopLevelAttribute && property.Type == schema.TypeMap && (attributeName == "tags" || attributeName == "tags_all") {
+		e.GoImports = append(e.GoImports, goImport{
+			Path:  "github.com/hashicorp/terraform-provider-aws/internal/tags",
+			Alias: "tftags",
+		})
+		fprintf(e.StructWriter, "tftags.Map")
+
+		if attributeName == "tags" {
+			e.HasTopLevelTagsMap = true
+		} else {
+			e.HasTopLevelTagsAllMap = true
+		}
+
+		if attributeName == "tags" && property.Optional {
+			fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+		} else {
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+		}
+
+		return nil
+	}
+
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
 	//
@@ -365,9 +643,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -375,9 +651,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -385,9 +659,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -400,15 +672,11 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,9 +693,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -438,9 +704,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -451,9 +715,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -477,20 +739,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			case schema.TypeString:
 				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
 
 			default:
 				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
@@ -633,12 +881,13 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.nestedModelName(path)
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "%s fwtypes.ListNestedObjectValueOf[%s] `tfsdk:%q`\n", naming.ToCamelCase(path[len(path)-1]), modelName, path[len(path)-1])
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
+			if err := e.emitNestedModel(path, modelName, v.Schema); err != nil {
 				return err
 			}
 
@@ -656,12 +905,13 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.nestedModelName(path)
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "%s fwtypes.SetNestedObjectValueOf[%s] `tfsdk:%q`\n", naming.ToCamelCase(path[len(path)-1]), modelName, path[len(path)-1])
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
+			if err := e.emitNestedModel(path, modelName, v.Schema); err != nil {
 				return err
 			}
 
@@ -731,6 +981,45 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedModel generates the Plugin Framework code for a Plugin SDK Block's nested attributes and blocks
+// and emits the generated code to the emitter's Writer. The nested block's model type is added to NestedModels.
+func (e *emitter) emitNestedModel(path []string, modelName string, schema map[string]*schema.Schema) error {
+	e.ImportProviderFrameworkTypes = true
+
+	structWriter := e.StructWriter
+	sbStruct := strings.Builder{}
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	e.NestedModels = append(e.NestedModels, fmt.Sprintf("type %s struct {\n%s}\n", modelName, sbStruct.String()))
+
+	return nil
+}
+
+// nestedModelName returns a unique model type name for the block at the specified path.
+// The block's own name is used unless another block of the same name has already been emitted.
+func (e *emitter) nestedModelName(path []string) string {
+	toModelName := func(s string) string {
+		s = naming.ToCamelCase(s)
+		return strings.ToLower(s[:1]) + s[1:] + "Model"
+	}
+
+	name := toModelName(path[len(path)-1])
+	if slices.Contains(e.nestedModelNames, name) {
+		name = toModelName(strings.Join(path, "_"))
+	}
+	e.nestedModelNames = append(e.nestedModelNames, name)
+
+	return name
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -902,22 +1191,35 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	Annotations                   []string // e.g. @Tags(identifierAttribute="arn")
+	ClientMethod                  string   // e.g. EC2Client
+	Create                        sdksource.Operation
+	Read                          sdksource.Operation
+	Update                        sdksource.Operation
+	Delete                        sdksource.Operation
+	DefaultCreateTimeout          string // e.g. 10 * time.Minute
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	EmitResourceCRUD              bool
 	EmitResourceImportState       bool
-	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasRegion                     bool
+	HasTags                       bool
 	HasTimeouts                   bool
+	HumanName                     string // e.g. EC2 Instance
+	ImportByID                    bool
+	ImportByIdentity              bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	Name                          string // e.g. Instance
+	NestedModels                  []string
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	SDKPackage                    string // e.g. ec2
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdksource inspects the Go source of a Plugin SDK v2 resource to find the AWS API calls,
+// finders and waiters made by its CRUD handlers so that they can be carried over to a Plugin Framework resource.
+package sdksource
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const sdkServicePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+var (
+	clientMethodRegexp = regexp.MustCompile(`^[A-Z][0-9A-Za-z]*Client$`)
+	nameArgRegexp      = regexp.MustCompile(`name="([^"]*)"`)
+
+	// sdkOnlyAnnotations are resource annotations that have no meaning for Plugin Framework resources.
+	sdkOnlyAnnotations = []string{
+		"@CustomImport",
+		"@IdAttrFormat",
+		"@SDKResource",
+		"@V60SDKv2Fix",
+		"@WrappedImport",
+	}
+)
+
+// Operation describes the calls made by a CRUD handler, in source order and without duplicates.
+type Operation struct {
+	APICalls []string // AWS API operations called on the service client, e.g. CreateQueue.
+	Finders  []string // find... functions called, e.g. findQueueAttributesByURL.
+	Waiters  []string // wait... functions called, e.g. waitQueueDeleted.
+}
+
+// Resource describes a Plugin SDK v2 resource's source.
+type Resource struct {
+	Annotations  []string // Constructor annotations that also apply to Plugin Framework resources, e.g. @Tags(identifierAttribute="arn").
+	ClientMethod string   // conns.AWSClient method that returns the service client, e.g. SQSClient.
+	Filename     string   // Path of the file containing the resource's constructor.
+	Importer     string   // Importer's StateContext function, e.g. schema.ImportStatePassthroughContext. Empty if the resource cannot be imported.
+	Name         string   // Human-friendly name from the @SDKResource annotation, e.g. Queue.
+	SDKPackage   string   // Name by which the resource's source refers to its AWS SDK for Go v2 service package, e.g. sqs.
+	SDKPath      string   // AWS SDK for Go v2 service package import path, e.g. github.com/aws/aws-sdk-go-v2/service/sqs.
+	Create       Operation
+	Read         Operation
+	Update       Operation
+	Delete       Operation
+}
+
+// Analyze parses the non-test Go files in dir and describes the resource of the specified type.
+// The resource's constructor must be annotated with @SDKResource and return a *schema.Resource literal.
+func Analyze(dir, typeName string) (*Resource, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %s: %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		files = append(files, file)
+	}
+
+	return analyzeFiles(fset, files, typeName)
+}
+
+func analyzeFiles(fset *token.FileSet, files []*ast.File, typeName string) (*Resource, error) {
+	funcs := make(map[string]*ast.FuncDecl)
+	funcFiles := make(map[string]*ast.File)
+	var constructor *ast.FuncDecl
+	var constructorFile *ast.File
+	resource := &Resource{}
+
+	annotation := fmt.Sprintf("@SDKResource(%q", typeName)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+
+			funcs[funcDecl.Name.Name] = funcDecl
+			funcFiles[funcDecl.Name.Name] = file
+
+			if funcDecl.Doc == nil || !strings.Contains(funcDecl.Doc.Text(), annotation) {
+				continue
+			}
+
+			constructor = funcDecl
+			constructorFile = file
+			for line := range strings.Lines(funcDecl.Doc.Text()) {
+				line = strings.TrimSpace(line)
+				if !strings.HasPrefix(line, "@") {
+					continue
+				}
+
+				if strings.HasPrefix(line, annotation) {
+					if m := nameArgRegexp.FindStringSubmatch(line); m != nil {
+						resource.Name = m[1]
+					}
+				}
+
+				if !slices.ContainsFunc(sdkOnlyAnnotations, func(v string) bool {
+					return line == v || strings.HasPrefix(line, v+"(")
+				}) {
+					resource.Annotations = append(resource.Annotations, line)
+				}
+			}
+		}
+	}
+
+	if constructorFile == nil {
+		return nil, fmt.Errorf("no function annotated with %s) found", annotation)
+	}
+
+	resource.Filename = fset.Position(constructorFile.Pos()).Filename
+	resource.SDKPackage, resource.SDKPath = sdkPackage(constructorFile)
+
+	fields := resourceLiteralFields(constructor)
+	if v, ok := fields["Importer"].(*ast.UnaryExpr); ok {
+		if lit, ok := v.X.(*ast.CompositeLit); ok {
+			for _, elt := range lit.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok && exprString(kv.Key) == "StateContext" {
+					resource.Importer = exprString(kv.Value)
+				}
+			}
+		}
+	}
+
+	// Resources use the WithoutTimeout or Context variants of CRUD handlers.
+	handler := func(crud string) string {
+		for _, suffix := range []string{"WithoutTimeout", "Context", ""} {
+			if v, ok := fields[crud+suffix].(*ast.Ident); ok {
+				return v.Name
+			}
+		}
+		return ""
+	}
+
+	for _, v := range []struct {
+		name      string
+		operation *Operation
+	}{
+		{handler("Create"), &resource.Create},
+		{handler("Read"), &resource.Read},
+		{handler("Update"), &resource.Update},
+		{handler("Delete"), &resource.Delete},
+	} {
+		if v.name == "" {
+			continue
+		}
+
+		funcDecl, ok := funcs[v.name]
+		if !ok {
+			return nil, fmt.Errorf("handler function %s not found", v.name)
+		}
+
+		if resource.SDKPackage == "" {
+			resource.SDKPackage, resource.SDKPath = sdkPackage(funcFiles[v.name])
+		}
+
+		clientMethod := analyzeOperation(funcDecl, v.operation)
+		if resource.ClientMethod == "" {
+			resource.ClientMethod = clientMethod
+		}
+	}
+
+	return resource, nil
+}
+
+// resourceLiteralFields returns the fields of the first &schema.Resource{...} literal in the constructor's body.
+func resourceLiteralFields(constructor *ast.FuncDecl) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr)
+
+	ast.Inspect(constructor.Body, func(n ast.Node) bool {
+		if len(fields) > 0 {
+			return false
+		}
+
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || exprString(lit.Type) != "schema.Resource" {
+			return true
+		}
+
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				fields[exprString(kv.Key)] = kv.Value
+			}
+		}
+
+		return false
+	})
+
+	return fields
+}
+
+// analyzeOperation records the calls made by a handler function and returns the name of the client method it uses.
+func analyzeOperation(funcDecl *ast.FuncDecl, operation *Operation) string {
+	var clientMethod string
+	clientVars := make(map[string]bool)
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			// conn := meta.(*conns.AWSClient).SQSClient(ctx)
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				break
+			}
+
+			if name := clientMethodName(n.Rhs[0]); name != "" {
+				if ident, ok := n.Lhs[0].(*ast.Ident); ok {
+					clientVars[ident.Name] = true
+				}
+				if clientMethod == "" {
+					clientMethod = name
+				}
+			}
+
+		case *ast.CallExpr:
+			switch fun := n.Fun.(type) {
+			case *ast.SelectorExpr:
+				if ident, ok := fun.X.(*ast.Ident); ok && clientVars[ident.Name] {
+					operation.APICalls = appendUnique(operation.APICalls, fun.Sel.Name)
+				}
+
+			case *ast.Ident:
+				switch {
+				case strings.HasPrefix(fun.Name, "find"):
+					operation.Finders = appendUnique(operation.Finders, fun.Name)
+				case strings.HasPrefix(fun.Name, "wait"):
+					operation.Waiters = appendUnique(operation.Waiters, fun.Name)
+				}
+			}
+		}
+
+		return true
+	})
+
+	return clientMethod
+}
+
+// clientMethodName returns the name of the method called if expr is a service client factory call, e.g. meta.(*conns.AWSClient).SQSClient(ctx).
+func clientMethodName(expr ast.Expr) string {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return ""
+	}
+
+	fun, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !clientMethodRegexp.MatchString(fun.Sel.Name) {
+		return ""
+	}
+
+	return fun.Sel.Name
+}
+
+// sdkPackage returns the name by which the file refers to its AWS SDK for Go v2 service package and the package's import path.
+func sdkPackage(file *ast.File) (string, string) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		v, ok := strings.CutPrefix(path, sdkServicePathPrefix)
+		if !ok || strings.Contains(v, "/") {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name, path
+		}
+
+		return v, path
+	}
+
+	return "", ""
+}
+
+// exprString returns the source form of an identifier or a qualified identifier.
+func exprString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok {
+			return x.Name + "." + expr.Sel.Name
+		}
+	}
+
+	return ""
+}
+
+func appendUnique(s []string, v string) []string {
+	if slices.Contains(s, v) {
+		return s
+	}
+
+	return append(s, v)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdksource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testResourceSource = `package sqs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKResource("aws_sqs_queue", name="Queue")
+// @Tags(identifierAttribute="id")
+// @IdentityAttribute("url")
+// @V60SDKv2Fix
+// @Testing(existsType="map[github.com/aws/aws-sdk-go-v2/service/sqs/types.QueueAttributeName]string")
+func resourceQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueCreate,
+		ReadWithoutTimeout:   resourceQueueRead,
+		UpdateWithoutTimeout: resourceQueueUpdate,
+		DeleteWithoutTimeout: resourceQueueDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"redrive_policy": {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
+		},
+	}
+}
+
+// @SDKResource("aws_sqs_queue_policy", name="Queue Policy")
+func resourceQueuePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQueuePolicyCreate,
+		ReadContext:   resourceQueueRead,
+	}
+}
+
+func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	input := &sqs.CreateQueueInput{}
+	outputRaw, err := tfresource.RetryWhenIsA[any, *awstypes.QueueDeletedRecently](ctx, queueCreatedTimeout, func(ctx context.Context) (any, error) {
+		return conn.CreateQueue(ctx, input)
+	})
+	if err != nil {
+		return nil
+	}
+
+	if err := waitQueueAttributesPropagated(ctx, conn, d.Id(), nil); err != nil {
+		return nil
+	}
+
+	return resourceQueueRead(ctx, d, meta)
+}
+
+func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(*conns.AWSClient)
+	conn := c.SQSClient(ctx)
+
+	output, err := findQueueAttributesByURL(ctx, conn, d.Id())
+	if err != nil {
+		return nil
+	}
+
+	return nil
+}
+
+func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	if _, err := conn.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{}); err != nil {
+		return nil
+	}
+	if _, err := conn.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{}); err != nil {
+		return nil
+	}
+
+	return resourceQueueRead(ctx, d, meta)
+}
+`
+
+const testResourceDeleteSource = `package sqs
+
+import (
+	"context"
+
+	sqssdk "github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	_, err := conn.DeleteQueue(ctx, &sqssdk.DeleteQueueInput{})
+	if err != nil {
+		return nil
+	}
+
+	if err := waitQueueDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return nil
+	}
+
+	return nil
+}
+`
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"queue.go":        testResourceSource,
+		"queue_delete.go": testResourceDeleteSource,
+		"queue_test.go":   "package sqs_test\n\nfunc syntax error",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := map[string]struct {
+		typeName    string
+		expected    *Resource
+		expectError bool
+	}{
+		"all handlers": {
+			typeName: "aws_sqs_queue",
+			expected: &Resource{
+				Annotations: []string{
+					`@Tags(identifierAttribute="id")`,
+					`@IdentityAttribute("url")`,
+					`@Testing(existsType="map[github.com/aws/aws-sdk-go-v2/service/sqs/types.QueueAttributeName]string")`,
+				},
+				ClientMethod: "SQSClient",
+				Filename:     filepath.Join(dir, "queue.go"),
+				Importer:     "schema.ImportStatePassthroughContext",
+				Name:         "Queue",
+				SDKPackage:   "sqs",
+				SDKPath:      "github.com/aws/aws-sdk-go-v2/service/sqs",
+				Create: Operation{
+					APICalls: []string{"CreateQueue"},
+					Waiters:  []string{"waitQueueAttributesPropagated"},
+				},
+				Read: Operation{
+					Finders: []string{"findQueueAttributesByURL"},
+				},
+				Update: Operation{
+					APICalls: []string{"SetQueueAttributes"},
+				},
+				Delete: Operation{
+					APICalls: []string{"DeleteQueue"},
+					Waiters:  []string{"waitQueueDeleted"},
+				},
+			},
+		},
+		"unknown type": {
+			typeName:    "aws_sqs_queue_redrive_policy",
+			expectError: true,
+		},
+		"unknown handler": {
+			typeName:    "aws_sqs_queue_policy",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Analyze(dir, testCase.typeName)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Analyze() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package testmigrate rewrites a Plugin SDK v2 resource's acceptance tests for the resource's Plugin Framework implementation.
+// Provider factories are replaced with acctest.ProtoV5ProviderFactories and, where the attribute's type is known,
+// resource.TestCheckResourceAttr... checks are replaced with the equivalent statecheck.StateCheck.
+package testmigrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Kind is the type of a resource attribute or block.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindBool
+	KindFloat64
+	KindInt64
+	KindString
+	KindList
+	KindMap
+	KindSet
+)
+
+const (
+	compareImportPath    = "github.com/hashicorp/terraform-plugin-testing/compare"
+	knownvalueImportPath = "github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	statecheckImportPath = "github.com/hashicorp/terraform-plugin-testing/statecheck"
+	tfjsonpathImportPath = "github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// Options configures a rewrite.
+type Options struct {
+	// Constants maps qualified constant names that tests use for attribute names and values,
+	// e.g. names.AttrName or acctest.CtOne, to their values.
+	Constants map[string]string
+	// KindOf returns the kind of the attribute or block at the specified path of attribute and block names,
+	// e.g. ["ebs_block_device", "volume_size"].
+	KindOf func(path []string) Kind
+}
+
+type edit struct {
+	start, end int
+	text       string
+}
+
+type rewriter struct {
+	edits   []edit
+	file    *ast.File
+	fset    *token.FileSet
+	imports []string
+	opts    Options
+	src     []byte
+}
+
+// Rewrite returns the rewritten, gofmt'd source of the specified acceptance test file.
+func Rewrite(src []byte, opts Options) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing test source: %w", err)
+	}
+
+	if opts.KindOf == nil {
+		opts.KindOf = func([]string) Kind { return KindUnknown }
+	}
+
+	r := &rewriter{
+		file: file,
+		fset: fset,
+		opts: opts,
+		src:  src,
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.CompositeLit); ok {
+			r.rewriteCompositeLit(lit)
+		}
+
+		return true
+	})
+
+	if err := r.addImports(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(r.edits, func(a, b edit) int {
+		return b.start - a.start
+	})
+
+	out := slices.Clone(src)
+	for _, e := range r.edits {
+		out = slices.Concat(out[:e.start], []byte(e.text), out[e.end:])
+	}
+
+	formatted, err := format.Source(out)
+	if err != nil {
+		return nil, fmt.Errorf("formatting rewritten test source: %w", err)
+	}
+
+	return formatted, nil
+}
+
+func (r *rewriter) rewriteCompositeLit(lit *ast.CompositeLit) {
+	var checkKV, stateChecksKV *ast.KeyValueExpr
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "ProviderFactories", "Providers", "ProtoV6ProviderFactories":
+			r.replace(kv, "ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories")
+		case "Check":
+			checkKV = kv
+		case "ConfigStateChecks":
+			stateChecksKV = kv
+		}
+	}
+
+	if checkKV == nil {
+		return
+	}
+
+	var existingStateChecks *ast.CompositeLit
+	if stateChecksKV != nil {
+		lit, ok := stateChecksKV.Value.(*ast.CompositeLit)
+		if !ok {
+			return
+		}
+		existingStateChecks = lit
+	}
+
+	// Check: resource.ComposeAggregateTestCheckFunc(...) or a single check function.
+	args := []ast.Expr{checkKV.Value}
+	var compose string
+	if call, ok := checkKV.Value.(*ast.CallExpr); ok {
+		if name := r.selectorName(call.Fun); name == "resource.ComposeTestCheckFunc" || name == "resource.ComposeAggregateTestCheckFunc" {
+			args = call.Args
+			compose = name
+		}
+	}
+
+	var remaining, stateChecks []string
+	for _, arg := range args {
+		if v, ok := r.stateCheck(arg); ok {
+			stateChecks = append(stateChecks, v)
+		} else {
+			remaining = append(remaining, r.source(arg))
+		}
+	}
+
+	if len(stateChecks) == 0 {
+		return
+	}
+
+	var sb strings.Builder
+	if len(remaining) > 0 {
+		if compose == "" {
+			compose = "resource.ComposeAggregateTestCheckFunc"
+		}
+		fmt.Fprintf(&sb, "Check: %s(\n", compose)
+		for _, v := range remaining {
+			fmt.Fprintf(&sb, "%s,\n", v)
+		}
+		sb.WriteString(")")
+	}
+
+	if existingStateChecks != nil {
+		// Append to the existing state checks.
+		var text strings.Builder
+		offset := r.offset(existingStateChecks.Rbrace)
+		if elts := existingStateChecks.Elts; len(elts) > 0 && !bytes.Contains(r.src[r.offset(elts[len(elts)-1].End()):offset], []byte(",")) {
+			text.WriteString(",\n")
+		}
+		for _, v := range stateChecks {
+			fmt.Fprintf(&text, "%s,\n", v)
+		}
+		r.edits = append(r.edits, edit{start: offset, end: offset, text: text.String()})
+		r.replaceOrRemove(lit, checkKV, sb.String())
+
+		return
+	}
+
+	if sb.Len() > 0 {
+		sb.WriteString(",\n")
+	}
+	sb.WriteString("ConfigStateChecks: []statecheck.StateCheck{\n")
+	for _, v := range stateChecks {
+		fmt.Fprintf(&sb, "%s,\n", v)
+	}
+	sb.WriteString("}")
+
+	r.replace(checkKV, sb.String())
+}
+
+// stateCheck returns the statecheck.StateCheck equivalent to the specified resource.TestCheckFunc, if there is one.
+func (r *rewriter) stateCheck(expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return "", false
+	}
+
+	switch name := r.selectorName(call.Fun); name {
+	case "resource.TestCheckResourceAttr":
+		if len(call.Args) != 3 {
+			return "", false
+		}
+
+		path, kind, size, ok := r.attributePath(call.Args[1])
+		if !ok {
+			return "", false
+		}
+
+		var value string
+		if size != KindUnknown {
+			n, ok := r.intValue(call.Args[2])
+			if !ok {
+				return "", false
+			}
+
+			switch size {
+			case KindList:
+				value = fmt.Sprintf("knownvalue.ListSizeExact(%d)", n)
+			case KindMap:
+				value = fmt.Sprintf("knownvalue.MapSizeExact(%d)", n)
+			case KindSet:
+				value = fmt.Sprintf("knownvalue.SetSizeExact(%d)", n)
+			default:
+				return "", false
+			}
+		} else {
+			if value, ok = r.knownValue(call.Args[2], kind); !ok {
+				return "", false
+			}
+		}
+
+		r.addImport(statecheckImportPath, knownvalueImportPath, tfjsonpathImportPath)
+
+		return fmt.Sprintf("statecheck.ExpectKnownValue(%s, %s, %s)", r.source(call.Args[0]), path, value), true
+
+	case "resource.TestCheckResourceAttrSet":
+		if len(call.Args) != 2 {
+			return "", false
+		}
+
+		path, _, size, ok := r.attributePath(call.Args[1])
+		if !ok || size != KindUnknown {
+			return "", false
+		}
+
+		r.addImport(statecheckImportPath, knownvalueImportPath, tfjsonpathImportPath)
+
+		return fmt.Sprintf("statecheck.ExpectKnownValue(%s, %s, knownvalue.NotNull())", r.source(call.Args[0]), path), true
+
+	case "resource.TestCheckResourceAttrPair":
+		if len(call.Args) != 4 {
+			return "", false
+		}
+
+		path1, _, size1, ok := r.attributePath(call.Args[1])
+		if !ok || size1 != KindUnknown {
+			return "", false
+		}
+		// The other resource's schema is unknown, so only its attribute name is translated.
+		path2, ok := r.otherAttributePath(call.Args[3])
+		if !ok {
+			return "", false
+		}
+
+		r.addImport(statecheckImportPath, compareImportPath, tfjsonpathImportPath)
+
+		return fmt.Sprintf("statecheck.CompareValuePairs(%s, %s, %s, %s, compare.ValuesSame())", r.source(call.Args[0]), path1, r.source(call.Args[2]), path2), true
+	}
+
+	return "", false
+}
+
+// attributePath translates a flatmap attribute address such as "ebs_block_device.0.volume_size" or "tags.%"
+// into a tfjsonpath.Path expression.
+// It returns the kind of the addressed value or, for "#" and "%" addresses, the kind of the collection whose size is addressed.
+func (r *rewriter) attributePath(expr ast.Expr) (string, Kind, Kind, bool) {
+	address, ok := r.stringValue(expr)
+	if !ok {
+		return "", KindUnknown, KindUnknown, false
+	}
+
+	segments := strings.Split(address, ".")
+
+	var sb strings.Builder
+	if _, isLiteral := expr.(*ast.BasicLit); !isLiteral && len(segments) == 1 {
+		// Keep a constant such as names.AttrName.
+		fmt.Fprintf(&sb, "tfjsonpath.New(%s)", r.source(expr))
+	} else {
+		fmt.Fprintf(&sb, "tfjsonpath.New(%q)", segments[0])
+	}
+
+	path := []string{segments[0]}
+	kind := r.opts.KindOf(path)
+	inMap := false
+
+	for i, segment := range segments[1:] {
+		last := i == len(segments)-2
+
+		switch {
+		case segment == "#" && last && (kind == KindList || kind == KindSet):
+			return sb.String(), KindUnknown, kind, true
+
+		case segment == "%" && last && kind == KindMap && !inMap:
+			return sb.String(), KindUnknown, KindMap, true
+
+		case kind == KindList:
+			n, err := strconv.Atoi(segment)
+			if err != nil {
+				return "", KindUnknown, KindUnknown, false
+			}
+			fmt.Fprintf(&sb, ".AtSliceIndex(%d)", n)
+			// A list element is either a nested block object or a primitive value.
+			kind = KindUnknown
+
+		case kind == KindMap && !inMap:
+			fmt.Fprintf(&sb, ".AtMapKey(%q)", segment)
+			// Maps in resource schemas hold strings.
+			kind = KindString
+			inMap = true
+
+		case kind == KindUnknown && !inMap && i > 0:
+			// An attribute of a nested block object.
+			path = append(path, segment)
+			fmt.Fprintf(&sb, ".AtMapKey(%q)", segment)
+			kind = r.opts.KindOf(path)
+
+		default:
+			// Set elements cannot be addressed by index.
+			return "", KindUnknown, KindUnknown, false
+		}
+	}
+
+	if kind == KindUnknown {
+		return "", KindUnknown, KindUnknown, false
+	}
+
+	return sb.String(), kind, KindUnknown, true
+}
+
+// otherAttributePath translates a top-level attribute name of a resource whose schema is unknown into a tfjsonpath.Path expression.
+func (r *rewriter) otherAttributePath(expr ast.Expr) (string, bool) {
+	address, ok := r.stringValue(expr)
+	if !ok || strings.Contains(address, ".") {
+		return "", false
+	}
+
+	if _, isLiteral := expr.(*ast.BasicLit); isLiteral {
+		return fmt.Sprintf("tfjsonpath.New(%q)", address), true
+	}
+
+	return fmt.Sprintf("tfjsonpath.New(%s)", r.source(expr)), true
+}
+
+// knownValue returns the knownvalue.Check for the expected value of an attribute of the specified kind.
+func (r *rewriter) knownValue(expr ast.Expr, kind Kind) (string, bool) {
+	switch kind {
+	case KindString:
+		return fmt.Sprintf("knownvalue.StringExact(%s)", r.source(expr)), true
+
+	case KindBool:
+		if v, ok := r.stringValue(expr); ok {
+			if b, err := strconv.ParseBool(v); err == nil {
+				return fmt.Sprintf("knownvalue.Bool(%t)", b), true
+			}
+		}
+
+	case KindInt64:
+		if n, ok := r.intValue(expr); ok {
+			return fmt.Sprintf("knownvalue.Int64Exact(%d)", n), true
+		}
+
+	case KindFloat64:
+		if v, ok := r.stringValue(expr); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return fmt.Sprintf("knownvalue.Float64Exact(%s)", strconv.FormatFloat(f, 'g', -1, 64)), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// stringValue returns the value of a string literal or a known constant.
+func (r *rewriter) stringValue(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return "", false
+		}
+		v, err := strconv.Unquote(expr.Value)
+		if err != nil {
+			return "", false
+		}
+		return v, true
+
+	case *ast.SelectorExpr:
+		v, ok := r.opts.Constants[r.selectorName(expr)]
+		return v, ok
+	}
+
+	return "", false
+}
+
+func (r *rewriter) intValue(expr ast.Expr) (int64, bool) {
+	v, ok := r.stringValue(expr)
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// selectorName returns the "package.Name" form of a selector expression.
+func (r *rewriter) selectorName(expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	return x.Name + "." + sel.Sel.Name
+}
+
+func (r *rewriter) addImport(paths ...string) {
+	for _, path := range paths {
+		if !slices.Contains(r.imports, path) {
+			r.imports = append(r.imports, path)
+		}
+	}
+}
+
+// addImports adds the imports needed by any state checks to the last import group.
+func (r *rewriter) addImports() error {
+	var missing []string
+	for _, path := range r.imports {
+		if !slices.ContainsFunc(r.file.Imports, func(spec *ast.ImportSpec) bool {
+			v, _ := strconv.Unquote(spec.Path.Value)
+			return v == path
+		}) {
+			missing = append(missing, path)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	for _, decl := range r.file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+
+		if !genDecl.Rparen.IsValid() {
+			return fmt.Errorf("unsupported import declaration at %s", r.fset.Position(genDecl.Pos()))
+		}
+
+		var sb strings.Builder
+		for _, path := range missing {
+			fmt.Fprintf(&sb, "\t%q\n", path)
+		}
+		offset := r.offset(genDecl.Rparen)
+		r.edits = append(r.edits, edit{start: offset, end: offset, text: sb.String()})
+
+		return nil
+	}
+
+	return fmt.Errorf("no import declaration found")
+}
+
+func (r *rewriter) replace(node ast.Node, text string) {
+	r.edits = append(r.edits, edit{start: r.offset(node.Pos()), end: r.offset(node.End()), text: text})
+}
+
+// replaceOrRemove replaces the specified element of a composite literal or, if text is empty, removes it and its trailing comma.
+func (r *rewriter) replaceOrRemove(lit *ast.CompositeLit, elt ast.Expr, text string) {
+	if text != "" {
+		r.replace(elt, text)
+		return
+	}
+
+	start, end := r.offset(elt.Pos()), r.offset(elt.End())
+	if i := bytes.IndexByte(r.src[end:r.offset(lit.Rbrace)], ','); i >= 0 {
+		end += i + 1
+	}
+	r.edits = append(r.edits, edit{start: start, end: end})
+}
+
+func (r *rewriter) offset(pos token.Pos) int {
+	return r.fset.Position(pos).Offset
+}
+
+func (r *rewriter) source(node ast.Node) string {
+	return string(r.src[r.offset(node.Pos()):r.offset(node.End())])
+}
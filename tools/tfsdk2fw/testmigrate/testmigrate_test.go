@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testmigrate
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testOptions = Options{
+	Constants: map[string]string{
+		"acctest.CtOne":         "1",
+		"acctest.CtTagsPercent": "tags.%",
+		"acctest.CtTrue":        "true",
+		"names.AttrARN":         "arn",
+		"names.AttrName":        "name",
+	},
+	KindOf: func(path []string) Kind {
+		switch {
+		case slices.Equal(path, []string{"arn"}), slices.Equal(path, []string{"name"}):
+			return KindString
+		case slices.Equal(path, []string{"enabled"}):
+			return KindBool
+		case slices.Equal(path, []string{"size"}):
+			return KindInt64
+		case slices.Equal(path, []string{"tags"}):
+			return KindMap
+		case slices.Equal(path, []string{"rule"}):
+			return KindList
+		case slices.Equal(path, []string{"rule", "priority"}):
+			return KindInt64
+		case slices.Equal(path, []string{"rule", "target"}):
+			return KindSet
+		}
+		return KindUnknown
+	},
+}
+
+func TestRewrite(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		src         string
+		expected    string
+		expectError bool
+	}{
+		"all checks converted": {
+			src: `package example_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "size", "10"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.priority", otherResourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+`,
+			expected: `package example_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("size"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("tags"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("tags").AtMapKey("Name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rule"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rule").AtSliceIndex(0).AtMapKey("priority"), knownvalue.Int64Exact(100)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New("rule").AtSliceIndex(0).AtMapKey("priority"), otherResourceName, tfjsonpath.New(names.AttrName), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+`,
+		},
+		"some checks converted": {
+			src: `package example_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.target.0", "x"),
+					resource.TestCheckResourceAttr(resourceName, "unknown", "x"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", strconv.FormatBool(enabled)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("size"), knownvalue.Null())},
+			},
+			{
+				Config: testAccThingConfig_basic(rName),
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", rName),
+			},
+		},
+	})
+}
+`,
+			expected: `package example_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.target.0", "x"),
+					resource.TestCheckResourceAttr(resourceName, "unknown", "x"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", strconv.FormatBool(enabled)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("size"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+				},
+			},
+			{
+				Config: testAccThingConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+`,
+		},
+		"nothing to convert": {
+			src: `package example_test
+
+import "testing"
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Check: testAccCheckThingExists(ctx, resourceName, &v),
+			},
+		},
+	})
+}
+`,
+			expected: `package example_test
+
+import "testing"
+
+func TestAccExampleThing_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Check: testAccCheckThingExists(ctx, resourceName, &v),
+			},
+		},
+	})
+}
+`,
+		},
+		"invalid source": {
+			src:         "package example_test\n\nfunc {",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Rewrite([]byte(testCase.src), testOptions)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Rewrite() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}